@@ -0,0 +1,66 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BGPPeerTemplateSpec defines the desired state of BGPPeerTemplate.
+// It contains the same fields as a Neighbor. A Neighbor referencing the
+// template inherits all the top level fields it does not set explicitly:
+// the fields it sets replace the template's ones as a whole, without merging
+// their nested fields, and the boolean fields enabled in the template can't
+// be disabled by the neighbor.
+// Address, Interface and Template can't be set on a template.
+// Creating or updating a template validates the FRRConfigurations
+// referencing it.
+type BGPPeerTemplateSpec struct {
+	Neighbor `json:",inline"`
+}
+
+// BGPPeerTemplateStatus defines the observed state of BGPPeerTemplate.
+type BGPPeerTemplateStatus struct {
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//nolint
+//+genclient
+
+// BGPPeerTemplate is a set of neighbor settings that can be shared by multiple
+// neighbors, across multiple FRRConfigurations.
+type BGPPeerTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BGPPeerTemplateSpec   `json:"spec,omitempty"`
+	Status BGPPeerTemplateStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// BGPPeerTemplateList contains a list of BGPPeerTemplate.
+type BGPPeerTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BGPPeerTemplate `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BGPPeerTemplate{}, &BGPPeerTemplateList{})
+}
@@ -32,6 +32,10 @@ type FRRNodeStateStatus struct {
 	LastConversionResult string `json:"lastConversionResult,omitempty"`
 	// LastReloadResult represents the status of the last configuration update operation by FRR, contains "success" or an error.
 	LastReloadResult string `json:"lastReloadResult,omitempty"`
+	// StartupProtection reports whether the advertisements of the routers holding them until the node is ready
+	// are still held, contains "released" or the list of vrfs being held. It is empty when no router holds its
+	// advertisements.
+	StartupProtection string `json:"startupProtection,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -26,6 +26,10 @@ type FRRConfigurationSpec struct {
 	// +optional
 	BGP BGPConfig `json:"bgp,omitempty"`
 
+	// Static is the configuration related to the static routes.
+	// +optional
+	Static StaticConfig `json:"static,omitempty"`
+
 	// Raw is a snippet of raw frr configuration that gets appended to the
 	// one rendered translating the type safe API.
 	// +optional
@@ -55,6 +59,58 @@ type RawConfig struct {
 	Config string `json:"rawConfig,omitempty"`
 }
 
+// StaticConfig is the configuration related to the static routes.
+type StaticConfig struct {
+	// Routes is the list of static routes we want FRR to configure.
+	// +optional
+	Routes []StaticRoute `json:"routes,omitempty"`
+}
+
+// StaticRoute represents a static route towards a given next hop.
+// At least one between NextHop and Interface must be set.
+type StaticRoute struct {
+	// Prefix is the destination of the route.
+	// +kubebuilder:validation:Format="cidr"
+	Prefix string `json:"prefix"`
+
+	// NextHop is the IP address of the next hop, of the same family of the prefix.
+	// +optional
+	NextHop string `json:"nextHop,omitempty"`
+
+	// Interface is the interface the route goes through.
+	// +optional
+	Interface string `json:"interface,omitempty"`
+
+	// Distance is the administrative distance of the route.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	Distance *uint32 `json:"distance,omitempty"`
+
+	// Tag is the tag attached to the route, that can be matched
+	// when redistributing it.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	// +optional
+	Tag *uint32 `json:"tag,omitempty"`
+
+	// VRF is the host vrf the route is configured in.
+	// +optional
+	VRF string `json:"vrf,omitempty"`
+
+	// NextHopVRF is the vrf the next hop is resolved in, when
+	// different from the one of the route, allowing route leaking.
+	// +optional
+	NextHopVRF string `json:"nextHopVRF,omitempty"`
+
+	// BFDProfile is the name of the BFD profile used to track the next hop.
+	// The route is removed when the BFD session goes down.
+	// It requires NextHop to be set, and the profile must be defined
+	// in the BGP BFDProfiles of the same configuration.
+	// +optional
+	BFDProfile string `json:"bfdProfile,omitempty"`
+}
+
 // BGPConfig is the configuration related to the BGP protocol.
 type BGPConfig struct {
 	// Routers is the list of routers we want FRR to configure (one per VRF).
@@ -93,6 +149,287 @@ type Router struct {
 	// EVPN specific configuration for the router.
 	// +optional
 	EVPN *EVPNConfig `json:"evpn,omitempty"`
+
+	// ClusterID is the route reflector cluster id of the router, used when
+	// some of its neighbors are route reflector clients. Defaults to the router id.
+	// It is either an IPv4 address or a 32 bits number.
+	// +optional
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// BestPath tunes the best path selection and the multipath
+	// behavior of the router.
+	// +optional
+	BestPath *BestPath `json:"bestPath,omitempty"`
+
+	// ListenRanges is the list of subnets the router accepts BGP sessions from,
+	// without the peers being listed explicitly as neighbors.
+	// +optional
+	ListenRanges []ListenRange `json:"listenRanges,omitempty"`
+
+	// ListenLimit is the maximum number of dynamic neighbors the router
+	// accepts across all its listen ranges.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	ListenLimit *uint32 `json:"listenLimit,omitempty"`
+
+	// Aggregates is the list of prefixes the router summarizes the more specific
+	// routes into. An aggregate can be advertised to the neighbors the same way
+	// as the entries of Prefixes.
+	// +optional
+	Aggregates []Aggregate `json:"aggregates,omitempty"`
+
+	// Redistribute is the list of sources of routes the router redistributes
+	// into BGP, in addition to the ones listed in Prefixes.
+	// +optional
+	Redistribute []Redistribute `json:"redistribute,omitempty"`
+
+	// StartupProtection delays or discourages the advertisement of the routes
+	// after FRR starts, so that the node does not attract traffic before its
+	// dataplane is ready.
+	// +optional
+	StartupProtection *StartupProtection `json:"startupProtection,omitempty"`
+
+	// GracefulRestart tunes the graceful restart timers of the router, applied
+	// to the neighbors with graceful restart enabled.
+	// +optional
+	GracefulRestart *GracefulRestart `json:"gracefulRestart,omitempty"`
+
+	// Confederation makes the router part of a BGP confederation, where ASN is
+	// the member AS of the router and the identifier is the AS number of the
+	// confederation as seen by the neighbors outside of it.
+	// +optional
+	Confederation *Confederation `json:"confederation,omitempty"`
+}
+
+// Confederation represents the BGP confederation a router is part of.
+type Confederation struct {
+	// Identifier is the AS number of the confederation.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	// +kubebuilder:validation:Format=int64
+	Identifier uint32 `json:"identifier"`
+
+	// Peers is the list of the member AS numbers of the confederation the router
+	// has neighbors in. The sessions with neighbors of those ASes are
+	// confederation sessions, not plain eBGP ones.
+	// +optional
+	Peers []uint32 `json:"peers,omitempty"`
+}
+
+// GracefulRestart represents the graceful restart timers of a router.
+type GracefulRestart struct {
+	// RestartTime is the time the neighbors are asked to retain the routes of
+	// the router while it restarts. Defaults to 120s.
+	// +kubebuilder:validation:XValidation:message="restart time should be between 0 seconds to 4095",rule="duration(self).getSeconds() >= 0 && duration(self).getSeconds() <= 4095"
+	// +kubebuilder:validation:XValidation:message="restart time should contain a whole number of seconds",rule="duration(self).getMilliseconds() % 1000 == 0"
+	// +optional
+	RestartTime *metav1.Duration `json:"restartTime,omitempty"`
+
+	// StalePathTime is the maximum time the routes learned from a restarting
+	// neighbor are retained once the session is established again. Defaults to 360s.
+	// +kubebuilder:validation:XValidation:message="stale path time should be between 1 seconds to 4095",rule="duration(self).getSeconds() >= 1 && duration(self).getSeconds() <= 4095"
+	// +kubebuilder:validation:XValidation:message="stale path time should contain a whole number of seconds",rule="duration(self).getMilliseconds() % 1000 == 0"
+	// +optional
+	StalePathTime *metav1.Duration `json:"stalePathTime,omitempty"`
+
+	// SelectDeferTime is the maximum time the router defers the best path
+	// selection after restarting, waiting for the end-of-RIB markers of its
+	// neighbors. Defaults to 360s.
+	// +kubebuilder:validation:XValidation:message="select defer time should be between 0 seconds to 3600",rule="duration(self).getSeconds() >= 0 && duration(self).getSeconds() <= 3600"
+	// +kubebuilder:validation:XValidation:message="select defer time should contain a whole number of seconds",rule="duration(self).getMilliseconds() % 1000 == 0"
+	// +optional
+	SelectDeferTime *metav1.Duration `json:"selectDeferTime,omitempty"`
+
+	// LongLivedStaleTime enables the long-lived graceful restart, retaining the
+	// routes of a restarting neighbor as stale for the given time once the
+	// graceful restart timers expired.
+	// +kubebuilder:validation:XValidation:message="long-lived stale time should be between 1 seconds to 16777215",rule="duration(self).getSeconds() >= 1 && duration(self).getSeconds() <= 16777215"
+	// +kubebuilder:validation:XValidation:message="long-lived stale time should contain a whole number of seconds",rule="duration(self).getMilliseconds() % 1000 == 0"
+	// +optional
+	LongLivedStaleTime *metav1.Duration `json:"longLivedStaleTime,omitempty"`
+}
+
+// StartupProtection represents the settings protecting the node from
+// attracting traffic right after FRR starts.
+type StartupProtection struct {
+	// UpdateDelay is the maximum time the router waits after startup for its
+	// neighbors to converge before computing the best paths and sending the
+	// first updates. It applies to all the BGP instances, so it can be set only
+	// on the router in the default VRF.
+	// +kubebuilder:validation:XValidation:message="update delay should be between 0 seconds to 3600",rule="duration(self).getSeconds() >= 0 && duration(self).getSeconds() <= 3600"
+	// +kubebuilder:validation:XValidation:message="update delay should contain a whole number of seconds",rule="duration(self).getMilliseconds() % 1000 == 0"
+	// +optional
+	UpdateDelay *metav1.Duration `json:"updateDelay,omitempty"`
+
+	// MaxMedOnStartup makes the router advertise its routes with a high MED
+	// for a period after startup, so that the neighbors prefer other paths.
+	// +optional
+	MaxMedOnStartup *MaxMedOnStartup `json:"maxMedOnStartup,omitempty"`
+
+	// HoldUntilReady withholds the advertisements of the router until the
+	// node is reported as ready by the given condition or annotation.
+	// +optional
+	HoldUntilReady *HoldUntilReady `json:"holdUntilReady,omitempty"`
+}
+
+// MaxMedOnStartup represents the MED advertised by a router right after startup.
+type MaxMedOnStartup struct {
+	// Period is how long after startup the routes are advertised with the MED.
+	// +kubebuilder:validation:XValidation:message="max med period should be between 5 seconds to 86400",rule="duration(self).getSeconds() >= 5 && duration(self).getSeconds() <= 86400"
+	// +kubebuilder:validation:XValidation:message="max med period should contain a whole number of seconds",rule="duration(self).getMilliseconds() % 1000 == 0"
+	Period metav1.Duration `json:"period"`
+
+	// Value is the MED advertised during the period. Defaults to 4294967294.
+	// +optional
+	Value *uint32 `json:"value,omitempty"`
+}
+
+// HoldUntilReady tells when the node is ready to have the routes of a router advertised.
+// The advertisements are released as soon as either the condition or the annotation
+// report the node as ready.
+type HoldUntilReady struct {
+	// ConditionType is the type of a node condition that, when True,
+	// reports the node as ready.
+	// +optional
+	ConditionType string `json:"conditionType,omitempty"`
+
+	// Annotation is the name of a node annotation that, when set to "true",
+	// reports the node as ready.
+	// +optional
+	Annotation string `json:"annotation,omitempty"`
+}
+
+// Redistribute represents a source of routes redistributed into BGP.
+type Redistribute struct {
+	// Source is the type of the routes to redistribute.
+	// +kubebuilder:validation:Enum=connected;static;kernel;table
+	Source RedistributeSource `json:"source"`
+
+	// Table is the id of the kernel routing table to redistribute the routes of.
+	// It is required when the source is table, and only ipv4 routes are
+	// redistributed from it.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Table *uint32 `json:"table,omitempty"`
+
+	// Prefixes restricts the redistributed routes to the ones matching
+	// any of the given selectors. When empty, all the routes are redistributed.
+	// +optional
+	Prefixes []PrefixSelector `json:"prefixes,omitempty"`
+
+	// Metric is the MED set on the redistributed routes.
+	// +optional
+	Metric *uint32 `json:"metric,omitempty"`
+}
+
+// GracefulRestartMode is the restricted graceful restart behavior of a neighbor.
+type GracefulRestartMode string
+
+const (
+	GracefulRestartHelper   GracefulRestartMode = "helper"
+	GracefulRestartDisabled GracefulRestartMode = "disabled"
+)
+
+// RedistributeSource is the type of routes redistributed into BGP.
+type RedistributeSource string
+
+const (
+	RedistributeConnected RedistributeSource = "connected"
+	RedistributeStatic    RedistributeSource = "static"
+	RedistributeKernel    RedistributeSource = "kernel"
+	RedistributeTable     RedistributeSource = "table"
+)
+
+// Aggregate represents a summary prefix covering more specific routes.
+type Aggregate struct {
+	// Prefix is the summary prefix, advertised when at least one more
+	// specific route is present in the BGP table.
+	Prefix string `json:"prefix"`
+
+	// SummaryOnly suppresses the advertisement of the more specific routes.
+	// +optional
+	SummaryOnly bool `json:"summaryOnly,omitempty"`
+
+	// ASSet makes the aggregate carry the set of the AS numbers found in
+	// the paths of the more specific routes.
+	// +optional
+	ASSet bool `json:"asSet,omitempty"`
+}
+
+// BestPath represents the knobs affecting how the router selects the best
+// paths, and how many of them are installed for a given prefix.
+type BestPath struct {
+	// MaximumPaths is the maximum number of equal cost paths installed
+	// for a prefix, allowing ECMP.
+	// +optional
+	MaximumPaths *MaximumPaths `json:"maximumPaths,omitempty"`
+
+	// ASPathMultipathRelax allows paths received from different ASNs, but with
+	// the same AS path length, to be considered for multipath.
+	// +optional
+	ASPathMultipathRelax bool `json:"asPathMultipathRelax,omitempty"`
+
+	// DeterministicMED makes the router compare the MED of the paths received
+	// from the same AS regardless of the order they were received in.
+	// +optional
+	DeterministicMED bool `json:"deterministicMED,omitempty"`
+
+	// CompareRouterID makes the router use the router id of the peers as a tie breaker
+	// between eBGP paths, instead of preferring the oldest one.
+	// +optional
+	CompareRouterID bool `json:"compareRouterID,omitempty"`
+
+	// AlwaysCompareMED makes the router compare the MED of paths received
+	// from different ASNs.
+	// +optional
+	AlwaysCompareMED bool `json:"alwaysCompareMED,omitempty"`
+}
+
+// MaximumPaths represents the maximum number of paths installed
+// for a prefix, for eBGP and iBGP learned paths.
+type MaximumPaths struct {
+	// EBGP is the maximum number of eBGP paths installed for a prefix.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	EBGP *uint32 `json:"ebgp,omitempty"`
+
+	// IBGP is the maximum number of iBGP paths installed for a prefix.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=64
+	// +optional
+	IBGP *uint32 `json:"ibgp,omitempty"`
+}
+
+// ListenRange represents a subnet the router accepts BGP sessions from.
+// Every peer connecting from the subnet gets the settings of the range.
+type ListenRange struct {
+	// Prefix is the subnet, in CIDR notation, the sessions are accepted from.
+	Prefix string `json:"prefix"`
+
+	// ASN is the AS number the peers of the range are expected to have.
+	// ASN and DynamicASN are mutually exclusive and one of them must be specified.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4294967295
+	// +kubebuilder:validation:Format=int64
+	// +optional
+	ASN uint32 `json:"asn,omitempty"`
+
+	// DynamicASN detects the AS number of the peers of the range
+	// without explicitly setting it via the ASN field. Limited to:
+	// internal - if the peer's ASN is different than the router's the connection is denied.
+	// external - if the peer's ASN is the same as the router's the connection is denied.
+	// ASN and DynamicASN are mutually exclusive and one of them must be specified.
+	// +kubebuilder:validation:Enum=internal;external
+	// +optional
+	DynamicASN DynamicASNMode `json:"dynamicASN,omitempty"`
+
+	// Template is the name of a BGPPeerTemplate in the same namespace as the
+	// frr-k8s daemon, whose settings are applied to all the peers of the range.
+	// +optional
+	Template string `json:"template,omitempty"`
 }
 
 // Import represents the possible imported VRFs to a given router.
@@ -100,8 +437,26 @@ type Import struct {
 	// Vrf is the vrf we want to import from
 	// +optional
 	VRF string `json:"vrf,omitempty"`
+
+	// Families is the list of IP families the routes are imported for.
+	// When empty, both the ipv4 and the ipv6 routes are imported.
+	// +optional
+	Families []ImportFamily `json:"families,omitempty"`
+
+	// Prefixes restricts the imported routes to the ones matching any of
+	// the given selectors. When empty, all the routes of the vrf are imported.
+	// +optional
+	Prefixes []PrefixSelector `json:"prefixes,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=ipv4;ipv6
+type ImportFamily string
+
+const (
+	ImportFamilyIPv4 ImportFamily = "ipv4"
+	ImportFamilyIPv6 ImportFamily = "ipv6"
+)
+
 // Neighbor represents a BGP Neighbor we want FRR to connect to.
 type Neighbor struct {
 	// ASN is the AS number to use for the local end of the session.
@@ -181,6 +536,19 @@ type Neighbor struct {
 	// +optional
 	EBGPMultiHop bool `json:"ebgpMultiHop,omitempty"`
 
+	// TTLSecurityHops enables the generalized TTL security mechanism (GTSM, RFC 5082),
+	// accepting only the packets of the neighbor coming from at most the given number
+	// of hops away. It can't be set together with EBGPMultiHop.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=254
+	// +optional
+	TTLSecurityHops *uint32 `json:"ttlSecurityHops,omitempty"`
+
+	// Passive makes the router never initiate the session, waiting for
+	// the neighbor to connect.
+	// +optional
+	Passive bool `json:"passive,omitempty"`
+
 	// BFDProfile is the name of the BFD Profile to be used for the BFD session associated
 	// to the BGP session. If not set, the BFD session won't be set up.
 	// +optional
@@ -193,6 +561,32 @@ type Neighbor struct {
 	// +optional
 	EnableGracefulRestart bool `json:"enableGracefulRestart,omitempty"`
 
+	// GracefulRestartMode sets a restricted graceful restart behavior for the
+	// neighbor: "helper" only retains the routes of the neighbor while it restarts,
+	// "disabled" turns graceful restart off for the neighbor. It can't be set together
+	// with EnableGracefulRestart.
+	// +kubebuilder:validation:Enum=helper;disabled
+	// +optional
+	GracefulRestartMode GracefulRestartMode `json:"gracefulRestartMode,omitempty"`
+
+	// Description is a free text describing the neighbor.
+	// +kubebuilder:validation:MaxLength=80
+	// +kubebuilder:validation:Pattern=`^[^\n\r]*$`
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Shutdown administratively shuts the session with the neighbor down,
+	// keeping its configuration in place.
+	// +optional
+	Shutdown bool `json:"shutdown,omitempty"`
+
+	// ShutdownMessage is the message sent to the neighbor when shutting
+	// the session down, as per RFC 8203. Requires Shutdown to be set.
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[^\n\r]*$`
+	// +optional
+	ShutdownMessage string `json:"shutdownMessage,omitempty"`
+
 	// ToAdvertise represents the list of prefixes to advertise to the given neighbor
 	// and the associated properties. Only applies to IPv4 and IPv6 unicast address families.
 	// +optional
@@ -217,6 +611,13 @@ type Neighbor struct {
 	// +kubebuilder:default:=false
 	DualStackAddressFamily bool `json:"dualStackAddressFamily,omitempty"`
 
+	// ExtendedNextHop enables the extended next hop capability (RFC 5549) on
+	// the session, allowing IPv4 prefixes to be exchanged with IPv6 next hops.
+	// It requires the session to be established over IPv6 and
+	// DualStackAddressFamily to be set.
+	// +optional
+	ExtendedNextHop bool `json:"extendedNextHop,omitempty"`
+
 	// LocalASN allows advertising a different AS number to the peer using BGP's
 	// local-as feature. When set, FRR will advertise this ASN to the peer
 	// via "neighbor <peer> local-as <ASN> no-prepend replace-as", overriding
@@ -235,6 +636,101 @@ type Neighbor struct {
 	// +kubebuilder:default:={"unicast"}
 	// +kubebuilder:validation:MaxItems=2
 	AddressFamilies []AddressFamily `json:"addressFamilies,omitempty"`
+
+	// Template is the name of a BGPPeerTemplate, living in the same namespace
+	// as the frr-k8s daemon, to inherit the neighbor settings from.
+	// Every top level field that is not set on the neighbor is taken from the
+	// template. Fields are not merged: a field set on the neighbor, such as
+	// toAdvertise, replaces the template's one entirely, including the nested
+	// fields it does not set. Note that boolean fields enabled in the template
+	// can't be disabled by the neighbor.
+	// +optional
+	Template string `json:"template,omitempty"`
+
+	// MaxPrefixes limits the number of prefixes accepted from the neighbor,
+	// per address family. When the limit is exceeded the session is torn down,
+	// unless warningOnly is set.
+	// +optional
+	MaxPrefixes *MaxPrefixes `json:"maxPrefixes,omitempty"`
+
+	// RouteReflectorClient makes the router act as a route reflector for
+	// the neighbor, for all the address families enabled on the session.
+	// It is supported only for iBGP sessions.
+	// +optional
+	RouteReflectorClient bool `json:"routeReflectorClient,omitempty"`
+
+	// AddPath enables advertising and receiving multiple paths for the same
+	// prefix to and from the neighbor, for all the address families enabled on the session.
+	// +optional
+	AddPath *AddPath `json:"addPath,omitempty"`
+
+	// AllowASIn accepts the routes received from the neighbor that carry
+	// the local AS in their AS path, for all the address families enabled on the session.
+	// +optional
+	AllowASIn *AllowASIn `json:"allowASIn,omitempty"`
+
+	// ASOverride replaces the AS of the neighbor with the local AS in the AS path
+	// of the routes advertised to it. It is supported only for eBGP sessions.
+	// +optional
+	ASOverride bool `json:"asOverride,omitempty"`
+
+	// RemovePrivateAS removes the private AS numbers from the AS path of the routes
+	// advertised to the neighbor. It is supported only for eBGP sessions.
+	// +optional
+	RemovePrivateAS *RemovePrivateAS `json:"removePrivateAS,omitempty"`
+}
+
+// AllowASIn represents how many times the local AS is accepted in the AS path of
+// the routes received from a neighbor. Count and Origin can't be set together.
+type AllowASIn struct {
+	// Count is the maximum number of occurrences of the local AS in the AS path.
+	// Defaults to 3.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	Count *uint32 `json:"count,omitempty"`
+
+	// Origin accepts the routes only when the local AS is their origin.
+	// +optional
+	Origin bool `json:"origin,omitempty"`
+}
+
+// RemovePrivateAS represents how the private AS numbers are removed from the AS path.
+type RemovePrivateAS struct {
+	// All removes the private AS numbers even when the AS path contains public ones.
+	// +optional
+	All bool `json:"all,omitempty"`
+
+	// ReplaceAS replaces the private AS numbers with the local AS instead of removing them.
+	// +optional
+	ReplaceAS bool `json:"replaceAS,omitempty"`
+}
+
+// MaxPrefixes represents the maximum number of prefixes accepted from a neighbor.
+type MaxPrefixes struct {
+	// IPv4 is the maximum number of IPv4 unicast prefixes accepted from the neighbor.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Format=int64
+	IPv4 *uint32 `json:"ipv4,omitempty"`
+
+	// IPv6 is the maximum number of IPv6 unicast prefixes accepted from the neighbor.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Format=int64
+	IPv6 *uint32 `json:"ipv6,omitempty"`
+
+	// WarningOnly makes FRR only log a warning when the limit is exceeded,
+	// instead of tearing down the session.
+	// +optional
+	WarningOnly bool `json:"warningOnly,omitempty"`
+
+	// RestartInterval is the time after which a session torn down because
+	// the limit was exceeded is re-established. It must be expressed in whole
+	// minutes, between 1m and 65535m. If not set, the session is not restarted
+	// automatically. Can't be set together with warningOnly.
+	// +optional
+	RestartInterval *metav1.Duration `json:"restartInterval,omitempty"`
 }
 
 // Advertise represents a list of prefixes to advertise to the given neighbor.
@@ -250,6 +746,11 @@ type Advertise struct {
 	// +optional
 	NextHop NextHop `json:"nextHop,omitempty"`
 
+	// DefaultOriginate advertises a default route to the neighbor, per IP family,
+	// regardless of the prefixes configured in the router.
+	// +optional
+	DefaultOriginate DefaultOriginate `json:"defaultOriginate,omitempty"`
+
 	// PrefixesWithLocalPref is a list of prefixes that are associated to a local
 	// preference when being advertised. The prefixes associated to a given local pref
 	// must be in the prefixes allowed to be advertised.
@@ -261,8 +762,71 @@ type Advertise struct {
 	// must be in the prefixes allowed to be advertised.
 	// +optional
 	PrefixesWithCommunity []CommunityPrefixes `json:"withCommunity,omitempty"`
+
+	// PrefixesWithASPathPrepend is a list of prefixes that are associated to an
+	// AS path prepend when being advertised. The prefixes associated to a given prepend
+	// must be in the prefixes allowed to be advertised.
+	// +optional
+	PrefixesWithASPathPrepend []ASPathPrependPrefixes `json:"withASPathPrepend,omitempty"`
+
+	// PrefixesWithMED is a list of prefixes that are associated to a multi exit
+	// discriminator when being advertised. The prefixes associated to a given MED
+	// must be in the prefixes allowed to be advertised.
+	// +optional
+	PrefixesWithMED []MEDPrefixes `json:"withMED,omitempty"`
+
+	// PrefixesWithOrigin is a list of prefixes that are associated to a BGP origin
+	// when being advertised. The prefixes associated to a given origin
+	// must be in the prefixes allowed to be advertised.
+	// +optional
+	PrefixesWithOrigin []OriginPrefixes `json:"withOrigin,omitempty"`
+
+	// PrefixesWithLinkBandwidth is a list of prefixes that are associated to a link
+	// bandwidth extended community when being advertised, letting the receiving
+	// routers weight their multipaths. The prefixes associated to a given bandwidth
+	// must be in the prefixes allowed to be advertised.
+	// +optional
+	PrefixesWithLinkBandwidth []LinkBandwidthPrefixes `json:"withLinkBandwidth,omitempty"`
+
+	// Conditional is a list of prefixes advertised only when a condition on the
+	// content of the BGP table is met, for example to advertise a backup prefix
+	// only when the primary route disappears. At most one entry per IP family is
+	// allowed, and the prefixes must be in the prefixes allowed to be advertised.
+	// +optional
+	Conditional []ConditionalAdvertisement `json:"conditional,omitempty"`
 }
 
+// ConditionalAdvertisement is a list of prefixes advertised depending on the
+// presence or absence of a given prefix in the BGP table.
+type ConditionalAdvertisement struct {
+	// Prefixes is the list of prefixes advertised conditionally. They must all
+	// belong to the same IP family.
+	// +kubebuilder:validation:MinItems=1
+	Prefixes []string `json:"prefixes"`
+
+	// Condition is the condition the advertisement of the prefixes depends on.
+	Condition AdvertisementCondition `json:"condition"`
+}
+
+// AdvertisementCondition is a condition on the content of the BGP table.
+type AdvertisementCondition struct {
+	// Type tells if the prefixes are advertised when a prefix matching the selector
+	// is in the BGP table ("exist") or when it isn't ("non-exist").
+	// +kubebuilder:validation:Enum=exist;non-exist
+	Type AdvertisementConditionType `json:"type"`
+
+	// Selector matches the prefixes of the BGP table the condition is evaluated on.
+	// It must belong to the same IP family of the conditional prefixes.
+	Selector PrefixSelector `json:"selector"`
+}
+
+type AdvertisementConditionType string
+
+const (
+	AdvertisementConditionExist    AdvertisementConditionType = "exist"
+	AdvertisementConditionNonExist AdvertisementConditionType = "non-exist"
+)
+
 // NextHop sets the BGP next-hop address for advertised prefixes.
 type NextHop struct {
 	// IPv4 is the next-hop address to advertise with IPv4 prefixes.
@@ -276,12 +840,53 @@ type NextHop struct {
 	IPv6 string `json:"ipv6,omitempty"`
 }
 
+// DefaultOriginate represents the default routes advertised to a neighbor.
+type DefaultOriginate struct {
+	// IPv4 advertises the 0.0.0.0/0 default route to the neighbor.
+	// +optional
+	IPv4 *DefaultRoute `json:"ipv4,omitempty"`
+
+	// IPv6 advertises the ::/0 default route to the neighbor.
+	// +optional
+	IPv6 *DefaultRoute `json:"ipv6,omitempty"`
+}
+
+// DefaultRoute represents the conditions a default route is advertised under.
+type DefaultRoute struct {
+	// ConditionPrefix makes the default route advertised only when the given
+	// prefix is present in the BGP table. It must belong to the same IP family
+	// of the default route.
+	// +optional
+	ConditionPrefix string `json:"conditionPrefix,omitempty"`
+}
+
 // Receive represents a list of prefixes to receive from the given neighbor.
 type Receive struct {
 	// Allowed is the list of prefixes allowed to be received from
 	// this neighbor.
 	// +optional
 	Allowed AllowedInPrefixes `json:"allowed,omitempty"`
+
+	// PrefixesWithLocalPref is a list of prefix selectors that are associated to a local
+	// preference when being received. The local preference is applied only to the
+	// prefixes that are allowed to be received: unless the allowed mode is "all", each
+	// selector must be covered by one of the allowed prefix selectors.
+	// +optional
+	PrefixesWithLocalPref []LocalPrefPrefixSelectors `json:"withLocalPref,omitempty"`
+
+	// PrefixesWithCommunity is a list of prefix selectors that are associated to a
+	// bgp community when being received. The community is applied only to the
+	// prefixes that are allowed to be received: unless the allowed mode is "all", each
+	// selector must be covered by one of the allowed prefix selectors.
+	// +optional
+	PrefixesWithCommunity []CommunityPrefixSelectors `json:"withCommunity,omitempty"`
+
+	// PrefixesWithWeight is a list of prefix selectors that are associated to a
+	// weight when being received. The weight is applied only to the
+	// prefixes that are allowed to be received: unless the allowed mode is "all", each
+	// selector must be covered by one of the allowed prefix selectors.
+	// +optional
+	PrefixesWithWeight []WeightPrefixSelectors `json:"withWeight,omitempty"`
 }
 
 // PrefixSelector is a filter of prefixes to receive.
@@ -302,9 +907,25 @@ type PrefixSelector struct {
 
 type AllowedInPrefixes struct {
 	Prefixes []PrefixSelector `json:"prefixes,omitempty"`
+	// Communities is a list of BGP communities. Routes carrying any of the
+	// given communities are allowed, regardless of their prefix: this widens
+	// the set of allowed routes instead of restricting the allowed prefixes.
+	// Both legacy (e.g. 10:100) and large (e.g. large:123:456:7890) communities
+	// are supported.
+	// +optional
+	Communities []string `json:"communities,omitempty"`
+	// ASPaths is a list of AS path regular expressions. Routes whose AS path
+	// matches any of the given expressions are allowed, regardless of their prefix:
+	// this widens the set of allowed routes instead of restricting the allowed prefixes.
+	// The expressions follow the FRR syntax, e.g. "^65001_", and are not validated
+	// beforehand: an expression FRR rejects makes the configuration reload fail.
+	// +optional
+	ASPaths []string `json:"asPaths,omitempty"`
 	// Mode is the mode to use when handling the prefixes.
-	// When set to "filtered", only the prefixes in the given list will be allowed.
-	// When set to "all", all the prefixes configured on the router will be allowed.
+	// When set to "filtered", only the routes matching any of the given prefixes, communities
+	// or as paths will be allowed. A route is allowed as soon as it matches one of them.
+	// When set to "all", all the prefixes configured on the router will be allowed, and communities
+	// and as paths can't be set.
 	// +kubebuilder:default:=filtered
 	Mode AllowMode `json:"mode,omitempty"`
 }
@@ -314,6 +935,8 @@ type AllowedOutPrefixes struct {
 	// Mode is the mode to use when handling the prefixes.
 	// When set to "filtered", only the prefixes in the given list will be allowed.
 	// When set to "all", all the prefixes configured on the router will be allowed.
+	// When set to "redistributed", all the prefixes configured on the router and
+	// all the routes redistributed into it will be allowed.
 	// +kubebuilder:default:=filtered
 	Mode AllowMode `json:"mode,omitempty"`
 }
@@ -335,9 +958,99 @@ type CommunityPrefixes struct {
 	// +kubebuilder:validation:Format="cidr"
 	Prefixes []string `json:"prefixes,omitempty"`
 	// Community is the community associated to the prefixes.
+	// Legacy (e.g. 10:100), large (e.g. large:123:456:7890) and route target or
+	// site of origin extended (e.g. rt:65000:100, soo:192.0.2.1:100) communities
+	// are supported.
+	Community string `json:"community,omitempty"`
+}
+
+// ASPathPrependPrefixes is a list of prefixes associated to an AS path prepend.
+type ASPathPrependPrefixes struct {
+	// Prefixes is the list of prefixes associated to the AS path prepend.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Format="cidr"
+	Prefixes []string `json:"prefixes,omitempty"`
+	// ASN is the AS number prepended to the AS path of the prefixes.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4294967295
+	// +kubebuilder:validation:Format=int64
+	ASN uint32 `json:"asn"`
+	// Repeat is the number of times the AS number is prepended.
+	// Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	Repeat uint32 `json:"repeat,omitempty"`
+}
+
+// MEDPrefixes is a list of prefixes associated to a multi exit discriminator.
+type MEDPrefixes struct {
+	// Prefixes is the list of prefixes associated to the MED.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Format="cidr"
+	Prefixes []string `json:"prefixes,omitempty"`
+	// MED is the multi exit discriminator (BGP metric) associated to the prefixes.
+	// +kubebuilder:validation:Format=int64
+	MED uint32 `json:"med,omitempty"`
+}
+
+// OriginPrefixes is a list of prefixes associated to a BGP origin.
+type OriginPrefixes struct {
+	// Prefixes is the list of prefixes associated to the origin.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Format="cidr"
+	Prefixes []string `json:"prefixes,omitempty"`
+	// Origin is the BGP origin attribute associated to the prefixes.
+	Origin BGPOrigin `json:"origin"`
+}
+
+// LinkBandwidthPrefixes is a list of prefixes associated to a link bandwidth.
+// Exactly one of Bandwidth and NumMultipaths must be set.
+type LinkBandwidthPrefixes struct {
+	// Prefixes is the list of prefixes associated to the link bandwidth.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:Format="cidr"
+	Prefixes []string `json:"prefixes,omitempty"`
+	// Bandwidth is the link bandwidth associated to the prefixes, in Mbps.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=25600
+	// +optional
+	Bandwidth *uint32 `json:"bandwidth,omitempty"`
+	// NumMultipaths associates the number of multipaths of the prefixes
+	// as their link bandwidth.
+	// +optional
+	NumMultipaths bool `json:"numMultipaths,omitempty"`
+}
+
+// LocalPrefPrefixSelectors is a list of prefix selectors associated to a local preference.
+type LocalPrefPrefixSelectors struct {
+	// Prefixes is the list of prefix selectors associated to the local preference.
+	// +kubebuilder:validation:MinItems=1
+	Prefixes []PrefixSelector `json:"prefixes,omitempty"`
+	// LocalPref is the local preference associated to the prefixes.
+	LocalPref uint32 `json:"localPref,omitempty"`
+}
+
+// CommunityPrefixSelectors is a list of prefix selectors associated to a community.
+type CommunityPrefixSelectors struct {
+	// Prefixes is the list of prefix selectors associated to the community.
+	// +kubebuilder:validation:MinItems=1
+	Prefixes []PrefixSelector `json:"prefixes,omitempty"`
+	// Community is the community associated to the prefixes.
 	Community string `json:"community,omitempty"`
 }
 
+// WeightPrefixSelectors is a list of prefix selectors associated to a weight.
+type WeightPrefixSelectors struct {
+	// Prefixes is the list of prefix selectors associated to the weight.
+	// +kubebuilder:validation:MinItems=1
+	Prefixes []PrefixSelector `json:"prefixes,omitempty"`
+	// Weight is the weight associated to the prefixes. Routes with a higher
+	// weight are preferred by the local node.
+	// +kubebuilder:validation:Maximum=65535
+	Weight uint32 `json:"weight,omitempty"`
+}
+
 // BFDProfile is the configuration related to the BFD protocol associated
 // to a BGP session.
 type BFDProfile struct {
@@ -444,12 +1157,46 @@ func init() {
 	SchemeBuilder.Register(&FRRConfiguration{}, &FRRConfigurationList{})
 }
 
-// +kubebuilder:validation:Enum=all;filtered
+// +kubebuilder:validation:Enum=all;filtered;redistributed
 type AllowMode string
 
 const (
 	AllowAll        AllowMode = "all"
 	AllowRestricted AllowMode = "filtered"
+	// AllowRedistributed is valid only for the advertised prefixes.
+	AllowRedistributed AllowMode = "redistributed"
+)
+
+// BGPOrigin is the value of the BGP origin path attribute.
+// +kubebuilder:validation:Enum=igp;egp;incomplete
+type BGPOrigin string
+
+const (
+	OriginIGP        BGPOrigin = "igp"
+	OriginEGP        BGPOrigin = "egp"
+	OriginIncomplete BGPOrigin = "incomplete"
+)
+
+// AddPath represents the add-path settings of a neighbor.
+type AddPath struct {
+	// TX enables advertising multiple paths for the same prefix to the neighbor. Limited to:
+	// all - all the known paths are advertised.
+	// bestpath-per-as - the best path learned from each neighboring AS is advertised.
+	// +kubebuilder:validation:Enum=all;bestpath-per-as
+	// +optional
+	TX AddPathTXMode `json:"tx,omitempty"`
+
+	// RX tells if multiple paths for the same prefix are accepted from the neighbor.
+	// Defaults to true.
+	// +optional
+	RX *bool `json:"rx,omitempty"`
+}
+
+type AddPathTXMode string
+
+const (
+	AddPathTXAll           AddPathTXMode = "all"
+	AddPathTXBestPathPerAS AddPathTXMode = "bestpath-per-as"
 )
 
 type DynamicASNMode string
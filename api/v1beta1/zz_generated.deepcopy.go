@@ -11,11 +11,52 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ASPathPrependPrefixes) DeepCopyInto(out *ASPathPrependPrefixes) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ASPathPrependPrefixes.
+func (in *ASPathPrependPrefixes) DeepCopy() *ASPathPrependPrefixes {
+	if in == nil {
+		return nil
+	}
+	out := new(ASPathPrependPrefixes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddPath) DeepCopyInto(out *AddPath) {
+	*out = *in
+	if in.RX != nil {
+		in, out := &in.RX, &out.RX
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddPath.
+func (in *AddPath) DeepCopy() *AddPath {
+	if in == nil {
+		return nil
+	}
+	out := new(AddPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Advertise) DeepCopyInto(out *Advertise) {
 	*out = *in
 	in.Allowed.DeepCopyInto(&out.Allowed)
 	out.NextHop = in.NextHop
+	in.DefaultOriginate.DeepCopyInto(&out.DefaultOriginate)
 	if in.PrefixesWithLocalPref != nil {
 		in, out := &in.PrefixesWithLocalPref, &out.PrefixesWithLocalPref
 		*out = make([]LocalPrefPrefixes, len(*in))
@@ -30,6 +71,41 @@ func (in *Advertise) DeepCopyInto(out *Advertise) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PrefixesWithASPathPrepend != nil {
+		in, out := &in.PrefixesWithASPathPrepend, &out.PrefixesWithASPathPrepend
+		*out = make([]ASPathPrependPrefixes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrefixesWithMED != nil {
+		in, out := &in.PrefixesWithMED, &out.PrefixesWithMED
+		*out = make([]MEDPrefixes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrefixesWithOrigin != nil {
+		in, out := &in.PrefixesWithOrigin, &out.PrefixesWithOrigin
+		*out = make([]OriginPrefixes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrefixesWithLinkBandwidth != nil {
+		in, out := &in.PrefixesWithLinkBandwidth, &out.PrefixesWithLinkBandwidth
+		*out = make([]LinkBandwidthPrefixes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditional != nil {
+		in, out := &in.Conditional, &out.Conditional
+		*out = make([]ConditionalAdvertisement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Advertise.
@@ -42,6 +118,57 @@ func (in *Advertise) DeepCopy() *Advertise {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvertisementCondition) DeepCopyInto(out *AdvertisementCondition) {
+	*out = *in
+	out.Selector = in.Selector
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvertisementCondition.
+func (in *AdvertisementCondition) DeepCopy() *AdvertisementCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvertisementCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Aggregate) DeepCopyInto(out *Aggregate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Aggregate.
+func (in *Aggregate) DeepCopy() *Aggregate {
+	if in == nil {
+		return nil
+	}
+	out := new(Aggregate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowASIn) DeepCopyInto(out *AllowASIn) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowASIn.
+func (in *AllowASIn) DeepCopy() *AllowASIn {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowASIn)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AllowedInPrefixes) DeepCopyInto(out *AllowedInPrefixes) {
 	*out = *in
@@ -50,6 +177,16 @@ func (in *AllowedInPrefixes) DeepCopyInto(out *AllowedInPrefixes) {
 		*out = make([]PrefixSelector, len(*in))
 		copy(*out, *in)
 	}
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ASPaths != nil {
+		in, out := &in.ASPaths, &out.ASPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedInPrefixes.
@@ -161,6 +298,96 @@ func (in *BGPConfig) DeepCopy() *BGPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerTemplate) DeepCopyInto(out *BGPPeerTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerTemplate.
+func (in *BGPPeerTemplate) DeepCopy() *BGPPeerTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPPeerTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerTemplateList) DeepCopyInto(out *BGPPeerTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BGPPeerTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerTemplateList.
+func (in *BGPPeerTemplateList) DeepCopy() *BGPPeerTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPPeerTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerTemplateSpec) DeepCopyInto(out *BGPPeerTemplateSpec) {
+	*out = *in
+	in.Neighbor.DeepCopyInto(&out.Neighbor)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerTemplateSpec.
+func (in *BGPPeerTemplateSpec) DeepCopy() *BGPPeerTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPPeerTemplateStatus) DeepCopyInto(out *BGPPeerTemplateStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPPeerTemplateStatus.
+func (in *BGPPeerTemplateStatus) DeepCopy() *BGPPeerTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPPeerTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPSessionState) DeepCopyInto(out *BGPSessionState) {
 	*out = *in
@@ -250,6 +477,46 @@ func (in *BGPSessionStateStatus) DeepCopy() *BGPSessionStateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BestPath) DeepCopyInto(out *BestPath) {
+	*out = *in
+	if in.MaximumPaths != nil {
+		in, out := &in.MaximumPaths, &out.MaximumPaths
+		*out = new(MaximumPaths)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BestPath.
+func (in *BestPath) DeepCopy() *BestPath {
+	if in == nil {
+		return nil
+	}
+	out := new(BestPath)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommunityPrefixSelectors) DeepCopyInto(out *CommunityPrefixSelectors) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]PrefixSelector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommunityPrefixSelectors.
+func (in *CommunityPrefixSelectors) DeepCopy() *CommunityPrefixSelectors {
+	if in == nil {
+		return nil
+	}
+	out := new(CommunityPrefixSelectors)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommunityPrefixes) DeepCopyInto(out *CommunityPrefixes) {
 	*out = *in
@@ -270,6 +537,87 @@ func (in *CommunityPrefixes) DeepCopy() *CommunityPrefixes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionalAdvertisement) DeepCopyInto(out *ConditionalAdvertisement) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Condition = in.Condition
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionalAdvertisement.
+func (in *ConditionalAdvertisement) DeepCopy() *ConditionalAdvertisement {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionalAdvertisement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Confederation) DeepCopyInto(out *Confederation) {
+	*out = *in
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Confederation.
+func (in *Confederation) DeepCopy() *Confederation {
+	if in == nil {
+		return nil
+	}
+	out := new(Confederation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultOriginate) DeepCopyInto(out *DefaultOriginate) {
+	*out = *in
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(DefaultRoute)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(DefaultRoute)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultOriginate.
+func (in *DefaultOriginate) DeepCopy() *DefaultOriginate {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultOriginate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRoute) DeepCopyInto(out *DefaultRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRoute.
+func (in *DefaultRoute) DeepCopy() *DefaultRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EVPNConfig) DeepCopyInto(out *EVPNConfig) {
 	*out = *in
@@ -365,6 +713,7 @@ func (in *FRRConfigurationList) DeepCopyObject() runtime.Object {
 func (in *FRRConfigurationSpec) DeepCopyInto(out *FRRConfigurationSpec) {
 	*out = *in
 	in.BGP.DeepCopyInto(&out.BGP)
+	in.Static.DeepCopyInto(&out.Static)
 	out.Raw = in.Raw
 	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
 }
@@ -573,16 +922,76 @@ func (in *FRRNodeStateStatus) DeepCopy() *FRRNodeStateStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Import) DeepCopyInto(out *Import) {
+func (in *GracefulRestart) DeepCopyInto(out *GracefulRestart) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Import.
-func (in *Import) DeepCopy() *Import {
-	if in == nil {
-		return nil
+	if in.RestartTime != nil {
+		in, out := &in.RestartTime, &out.RestartTime
+		*out = new(v1.Duration)
+		**out = **in
 	}
-	out := new(Import)
+	if in.StalePathTime != nil {
+		in, out := &in.StalePathTime, &out.StalePathTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SelectDeferTime != nil {
+		in, out := &in.SelectDeferTime, &out.SelectDeferTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LongLivedStaleTime != nil {
+		in, out := &in.LongLivedStaleTime, &out.LongLivedStaleTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GracefulRestart.
+func (in *GracefulRestart) DeepCopy() *GracefulRestart {
+	if in == nil {
+		return nil
+	}
+	out := new(GracefulRestart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HoldUntilReady) DeepCopyInto(out *HoldUntilReady) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HoldUntilReady.
+func (in *HoldUntilReady) DeepCopy() *HoldUntilReady {
+	if in == nil {
+		return nil
+	}
+	out := new(HoldUntilReady)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Import) DeepCopyInto(out *Import) {
+	*out = *in
+	if in.Families != nil {
+		in, out := &in.Families, &out.Families
+		*out = make([]ImportFamily, len(*in))
+		copy(*out, *in)
+	}
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]PrefixSelector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Import.
+func (in *Import) DeepCopy() *Import {
+	if in == nil {
+		return nil
+	}
+	out := new(Import)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -624,6 +1033,66 @@ func (in *L3VNI) DeepCopy() *L3VNI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinkBandwidthPrefixes) DeepCopyInto(out *LinkBandwidthPrefixes) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkBandwidthPrefixes.
+func (in *LinkBandwidthPrefixes) DeepCopy() *LinkBandwidthPrefixes {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkBandwidthPrefixes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ListenRange) DeepCopyInto(out *ListenRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenRange.
+func (in *ListenRange) DeepCopy() *ListenRange {
+	if in == nil {
+		return nil
+	}
+	out := new(ListenRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalPrefPrefixSelectors) DeepCopyInto(out *LocalPrefPrefixSelectors) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]PrefixSelector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalPrefPrefixSelectors.
+func (in *LocalPrefPrefixSelectors) DeepCopy() *LocalPrefPrefixSelectors {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalPrefPrefixSelectors)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalPrefPrefixes) DeepCopyInto(out *LocalPrefPrefixes) {
 	*out = *in
@@ -644,6 +1113,102 @@ func (in *LocalPrefPrefixes) DeepCopy() *LocalPrefPrefixes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MEDPrefixes) DeepCopyInto(out *MEDPrefixes) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MEDPrefixes.
+func (in *MEDPrefixes) DeepCopy() *MEDPrefixes {
+	if in == nil {
+		return nil
+	}
+	out := new(MEDPrefixes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxMedOnStartup) DeepCopyInto(out *MaxMedOnStartup) {
+	*out = *in
+	out.Period = in.Period
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxMedOnStartup.
+func (in *MaxMedOnStartup) DeepCopy() *MaxMedOnStartup {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxMedOnStartup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaxPrefixes) DeepCopyInto(out *MaxPrefixes) {
+	*out = *in
+	if in.IPv4 != nil {
+		in, out := &in.IPv4, &out.IPv4
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.RestartInterval != nil {
+		in, out := &in.RestartInterval, &out.RestartInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaxPrefixes.
+func (in *MaxPrefixes) DeepCopy() *MaxPrefixes {
+	if in == nil {
+		return nil
+	}
+	out := new(MaxPrefixes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaximumPaths) DeepCopyInto(out *MaximumPaths) {
+	*out = *in
+	if in.EBGP != nil {
+		in, out := &in.EBGP, &out.EBGP
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.IBGP != nil {
+		in, out := &in.IBGP, &out.IBGP
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaximumPaths.
+func (in *MaximumPaths) DeepCopy() *MaximumPaths {
+	if in == nil {
+		return nil
+	}
+	out := new(MaximumPaths)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Neighbor) DeepCopyInto(out *Neighbor) {
 	*out = *in
@@ -668,6 +1233,11 @@ func (in *Neighbor) DeepCopyInto(out *Neighbor) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.TTLSecurityHops != nil {
+		in, out := &in.TTLSecurityHops, &out.TTLSecurityHops
+		*out = new(uint32)
+		**out = **in
+	}
 	in.ToAdvertise.DeepCopyInto(&out.ToAdvertise)
 	in.ToReceive.DeepCopyInto(&out.ToReceive)
 	if in.AddressFamilies != nil {
@@ -675,6 +1245,26 @@ func (in *Neighbor) DeepCopyInto(out *Neighbor) {
 		*out = make([]AddressFamily, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxPrefixes != nil {
+		in, out := &in.MaxPrefixes, &out.MaxPrefixes
+		*out = new(MaxPrefixes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AddPath != nil {
+		in, out := &in.AddPath, &out.AddPath
+		*out = new(AddPath)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AllowASIn != nil {
+		in, out := &in.AllowASIn, &out.AllowASIn
+		*out = new(AllowASIn)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RemovePrivateAS != nil {
+		in, out := &in.RemovePrivateAS, &out.RemovePrivateAS
+		*out = new(RemovePrivateAS)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neighbor.
@@ -702,6 +1292,26 @@ func (in *NextHop) DeepCopy() *NextHop {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OriginPrefixes) DeepCopyInto(out *OriginPrefixes) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OriginPrefixes.
+func (in *OriginPrefixes) DeepCopy() *OriginPrefixes {
+	if in == nil {
+		return nil
+	}
+	out := new(OriginPrefixes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrefixSelector) DeepCopyInto(out *PrefixSelector) {
 	*out = *in
@@ -736,6 +1346,27 @@ func (in *RawConfig) DeepCopy() *RawConfig {
 func (in *Receive) DeepCopyInto(out *Receive) {
 	*out = *in
 	in.Allowed.DeepCopyInto(&out.Allowed)
+	if in.PrefixesWithLocalPref != nil {
+		in, out := &in.PrefixesWithLocalPref, &out.PrefixesWithLocalPref
+		*out = make([]LocalPrefPrefixSelectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrefixesWithCommunity != nil {
+		in, out := &in.PrefixesWithCommunity, &out.PrefixesWithCommunity
+		*out = make([]CommunityPrefixSelectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrefixesWithWeight != nil {
+		in, out := &in.PrefixesWithWeight, &out.PrefixesWithWeight
+		*out = make([]WeightPrefixSelectors, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receive.
@@ -748,6 +1379,51 @@ func (in *Receive) DeepCopy() *Receive {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Redistribute) DeepCopyInto(out *Redistribute) {
+	*out = *in
+	if in.Table != nil {
+		in, out := &in.Table, &out.Table
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]PrefixSelector, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Redistribute.
+func (in *Redistribute) DeepCopy() *Redistribute {
+	if in == nil {
+		return nil
+	}
+	out := new(Redistribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemovePrivateAS) DeepCopyInto(out *RemovePrivateAS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemovePrivateAS.
+func (in *RemovePrivateAS) DeepCopy() *RemovePrivateAS {
+	if in == nil {
+		return nil
+	}
+	out := new(RemovePrivateAS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Router) DeepCopyInto(out *Router) {
 	*out = *in
@@ -766,13 +1442,57 @@ func (in *Router) DeepCopyInto(out *Router) {
 	if in.Imports != nil {
 		in, out := &in.Imports, &out.Imports
 		*out = make([]Import, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.EVPN != nil {
 		in, out := &in.EVPN, &out.EVPN
 		*out = new(EVPNConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BestPath != nil {
+		in, out := &in.BestPath, &out.BestPath
+		*out = new(BestPath)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ListenRanges != nil {
+		in, out := &in.ListenRanges, &out.ListenRanges
+		*out = make([]ListenRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.ListenLimit != nil {
+		in, out := &in.ListenLimit, &out.ListenLimit
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Aggregates != nil {
+		in, out := &in.Aggregates, &out.Aggregates
+		*out = make([]Aggregate, len(*in))
+		copy(*out, *in)
+	}
+	if in.Redistribute != nil {
+		in, out := &in.Redistribute, &out.Redistribute
+		*out = make([]Redistribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StartupProtection != nil {
+		in, out := &in.StartupProtection, &out.StartupProtection
+		*out = new(StartupProtection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GracefulRestart != nil {
+		in, out := &in.GracefulRestart, &out.GracefulRestart
+		*out = new(GracefulRestart)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Confederation != nil {
+		in, out := &in.Confederation, &out.Confederation
+		*out = new(Confederation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Router.
@@ -800,6 +1520,83 @@ func (in *SecretReference) DeepCopy() *SecretReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupProtection) DeepCopyInto(out *StartupProtection) {
+	*out = *in
+	if in.UpdateDelay != nil {
+		in, out := &in.UpdateDelay, &out.UpdateDelay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxMedOnStartup != nil {
+		in, out := &in.MaxMedOnStartup, &out.MaxMedOnStartup
+		*out = new(MaxMedOnStartup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HoldUntilReady != nil {
+		in, out := &in.HoldUntilReady, &out.HoldUntilReady
+		*out = new(HoldUntilReady)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupProtection.
+func (in *StartupProtection) DeepCopy() *StartupProtection {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupProtection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticConfig) DeepCopyInto(out *StaticConfig) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]StaticRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticConfig.
+func (in *StaticConfig) DeepCopy() *StaticConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StaticRoute) DeepCopyInto(out *StaticRoute) {
+	*out = *in
+	if in.Distance != nil {
+		in, out := &in.Distance, &out.Distance
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticRoute.
+func (in *StaticRoute) DeepCopy() *StaticRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(StaticRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VNIProperties) DeepCopyInto(out *VNIProperties) {
 	*out = *in
@@ -824,3 +1621,23 @@ func (in *VNIProperties) DeepCopy() *VNIProperties {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightPrefixSelectors) DeepCopyInto(out *WeightPrefixSelectors) {
+	*out = *in
+	if in.Prefixes != nil {
+		in, out := &in.Prefixes, &out.Prefixes
+		*out = make([]PrefixSelector, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightPrefixSelectors.
+func (in *WeightPrefixSelectors) DeepCopy() *WeightPrefixSelectors {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightPrefixSelectors)
+	in.DeepCopyInto(out)
+	return out
+}
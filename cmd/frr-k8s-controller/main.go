@@ -72,19 +72,21 @@ const (
 )
 
 type params struct {
-	metricsAddr          string
-	metricsCertDir       string
-	healthProbeAddr      string
-	logLevel             string
-	nodeName             string
-	namespace            string
-	podName              string
-	pprofAddr            string
-	alwaysBlockCIDRs     string
-	tlsCipherSuites      string
-	tlsCurvePreferences  string
-	tlsMinVersion        string
-	bgpDebounceTimeoutMs string
+	metricsAddr                string
+	metricsCertDir             string
+	healthProbeAddr            string
+	logLevel                   string
+	nodeName                   string
+	namespace                  string
+	podName                    string
+	pprofAddr                  string
+	alwaysBlockCIDRs           string
+	tlsCipherSuites            string
+	tlsCurvePreferences        string
+	tlsMinVersion              string
+	bgpDebounceTimeoutMs       string
+	gracefulShutdownOnCordon   bool
+	gracefulShutdownAnnotation string
 }
 
 func main() {
@@ -106,6 +108,11 @@ func main() {
 		"BGP debounce timeout for FRR configuration reloads, in milliseconds. "+
 			"Can also be set via FRR_K8S_BGP_DEBOUNCE_TIMEOUT. Default is 3000 ms. This feature is experimental.")
 
+	flag.BoolVar(&params.gracefulShutdownOnCordon, "graceful-shutdown-on-cordon", false,
+		"Gracefully shut down the BGP sessions (GRACEFUL_SHUTDOWN community and local preference 0) while the node is cordoned.")
+	flag.StringVar(&params.gracefulShutdownAnnotation, "graceful-shutdown-annotation", "",
+		"When set, gracefully shut down the BGP sessions while the node carries an annotation with this name.")
+
 	opts := zap.Options{
 		Development: true,
 	}
@@ -145,6 +152,7 @@ func main() {
 				&corev1.Pod{}:                        namespaceSelector,
 				&frrk8sv1beta1.FRRConfiguration{}:    namespaceSelector,
 				&frrk8sv1beta1.FRRK8sConfiguration{}: namespaceSelector,
+				&frrk8sv1beta1.BGPPeerTemplate{}:     namespaceSelector,
 			},
 		},
 		Metrics: metricsserver.Options{
@@ -227,6 +235,10 @@ func startFRRControllers(ctx context.Context, mgr manager.Manager, params params
 		AlwaysBlockCIDRS: alwaysBlock,
 		DefaultLogLevel:  defaultLogLevel,
 		Namespace:        params.namespace,
+		GracefulShutdown: controller.GracefulShutdownSettings{
+			OnCordon:   params.gracefulShutdownOnCordon,
+			Annotation: params.gracefulShutdownAnnotation,
+		},
 	}
 	if err = configReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "FRRConfiguration")
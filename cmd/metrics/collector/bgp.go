@@ -35,6 +35,13 @@ var (
 		nil,
 	)
 
+	maxPrefixesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(Namespace, Subsystem, MaxPrefixes.Name),
+		MaxPrefixes.Help,
+		[]string{"peer", "vrf", "family"},
+		nil,
+	)
+
 	opensSentDesc = prometheus.NewDesc(
 		prometheus.BuildFQName(Namespace, Subsystem, "opens_sent"),
 		"Number of BGP open messages sent",
@@ -125,6 +132,7 @@ func (c *bgp) Describe(ch chan<- *prometheus.Desc) {
 	ch <- sessionUpDesc
 	ch <- prefixesDesc
 	ch <- receivedPrefixesDesc
+	ch <- maxPrefixesDesc
 	ch <- opensSentDesc
 	ch <- opensReceivedDesc
 	ch <- notificationsSentDesc
@@ -159,6 +167,9 @@ func updateNeighborsMetrics(ch chan<- prometheus.Metric, neighbors map[string][]
 			ch <- prometheus.MustNewConstMetric(sessionUpDesc, prometheus.GaugeValue, float64(sessionUp), peerLabel, vrf)
 			ch <- prometheus.MustNewConstMetric(prefixesDesc, prometheus.GaugeValue, float64(n.PrefixSent), peerLabel, vrf)
 			ch <- prometheus.MustNewConstMetric(receivedPrefixesDesc, prometheus.GaugeValue, float64(n.PrefixReceived), peerLabel, vrf)
+			for family, limit := range n.MaxPrefixes {
+				ch <- prometheus.MustNewConstMetric(maxPrefixesDesc, prometheus.GaugeValue, float64(limit), peerLabel, vrf, family)
+			}
 			ch <- prometheus.MustNewConstMetric(opensSentDesc, prometheus.CounterValue, float64(n.MsgStats.OpensSent), peerLabel, vrf)
 			ch <- prometheus.MustNewConstMetric(opensReceivedDesc, prometheus.CounterValue, float64(n.MsgStats.OpensReceived), peerLabel, vrf)
 			ch <- prometheus.MustNewConstMetric(notificationsSentDesc, prometheus.CounterValue, float64(n.MsgStats.NotificationsSent), peerLabel, vrf)
@@ -4,6 +4,7 @@ package collector
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 	"text/template"
 
@@ -22,6 +23,11 @@ var (
 	# HELP frrk8s_bgp_keepalives_sent Number of BGP keepalive messages sent
 	# TYPE frrk8s_bgp_keepalives_sent counter
 	frrk8s_bgp_keepalives_sent{peer="{{ .NeighborIP }}", vrf="{{ .NeighborVRF }}"} {{ .KeepalivesSent }}
+	{{- if .MaxPrefixes }}
+	# HELP frrk8s_bgp_max_prefixes Maximum number of prefixes accepted on the BGP session for the address family
+	# TYPE frrk8s_bgp_max_prefixes gauge
+	frrk8s_bgp_max_prefixes{family="ipv4Unicast", peer="{{ .NeighborIP }}", vrf="{{ .NeighborVRF }}"} {{ .MaxPrefixes }}
+	{{- end }}
 	# HELP frrk8s_bgp_notifications_sent Number of BGP notification messages sent
 	# TYPE frrk8s_bgp_notifications_sent counter
 	frrk8s_bgp_notifications_sent{peer="{{ .NeighborIP }}", vrf="{{ .NeighborVRF }}"} {{ .NotificationsSent }}
@@ -61,6 +67,7 @@ var (
 		neighborVRF          string
 		announcedPrefixes    int
 		receivedPrefixes     int
+		maxPrefixes          int
 		sessionUp            int
 		updatesTotal         int
 		updatesTotalReceived int
@@ -92,6 +99,26 @@ var (
 			totalSent:            15,
 			totalReceived:        15,
 		},
+		{
+			desc:                 "Output contains IPv4 advertisements with max prefixes",
+			vtyshOutput:          neighborsIPv4WithMaxPrefixes,
+			neighborIP:           "172.18.0.4",
+			neighborVRF:          "default",
+			announcedPrefixes:    3,
+			receivedPrefixes:     3,
+			maxPrefixes:          100,
+			sessionUp:            1,
+			updatesTotal:         3,
+			updatesTotalReceived: 3,
+			keepalivesSent:       4,
+			keepalivesReceived:   4,
+			opensSent:            1,
+			opensReceived:        1,
+			routeRefreshSent:     5,
+			notificationsSent:    2,
+			totalSent:            15,
+			totalReceived:        15,
+		},
 		{
 			desc:                 "Output contains mixed IPv4 and IPv6 advertisements",
 			vtyshOutput:          neighborsDual,
@@ -229,6 +256,12 @@ var (
 		}
 	  }	  
 	`
+	neighborsIPv4WithMaxPrefixes = strings.Replace(neighborsIPv4Only,
+		`"acceptedPrefixCounter":3,`,
+		`"acceptedPrefixCounter":3,
+			  "prefixAllowedMax":100,
+			  "prefixAllowedMaxWarning":true,`, 1)
+
 	neighborsDual = `
 	{
 		"172.18.0.4":{
@@ -455,6 +488,7 @@ func TestCollect(t *testing.T) {
 				"NeighborVRF":          tc.neighborVRF,
 				"AnnouncedPrefixes":    tc.announcedPrefixes,
 				"ReceivedPrefixes":     tc.receivedPrefixes,
+				"MaxPrefixes":          tc.maxPrefixes,
 				"SessionUp":            tc.sessionUp,
 				"UpdatesTotal":         tc.updatesTotal,
 				"UpdatesTotalReceived": tc.updatesTotalReceived,
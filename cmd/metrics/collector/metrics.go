@@ -30,4 +30,9 @@ var (
 		Name: "received_prefixes_total",
 		Help: "Number of prefixes currently being received on the BGP session",
 	}
+
+	MaxPrefixes = metric{
+		Name: "max_prefixes",
+		Help: "Maximum number of prefixes accepted on the BGP session for the address family",
+	}
 )
@@ -31,6 +31,7 @@ const (
 	peerLabel       = "frrk8s.metallb.io/peer"
 	vrfLabel        = "frrk8s.metallb.io/vrf"
 	noBFDConfigured = "N/A"
+	adminShutdown   = "AdminShutdown"
 )
 
 type BGPPeersFetcher func() (map[string][]*frr.Neighbor, error)
@@ -235,11 +236,15 @@ func (r *BGPSessionStateReconciler) desiredStateFor(neigh *frr.Neighbor, vrf str
 	if bfdStatus == "" {
 		bfdStatus = noBFDConfigured
 	}
+	bgpStatus := neigh.BGPState
+	if neigh.AdminShutdown {
+		bgpStatus = adminShutdown
+	}
 	desired.Status = frrk8sv1beta1.BGPSessionStateStatus{
 		Node:      r.NodeName,
 		Peer:      labelFormatForNeighbor(neigh.ID),
 		VRF:       vrf,
-		BGPStatus: neigh.BGPState,
+		BGPStatus: bgpStatus,
 		BFDStatus: bfdStatus,
 	}
 	return desired
@@ -36,11 +36,15 @@ func (f fakeBGPFetcher) expectedStatuses() map[string]map[string]frrk8sv1beta1.B
 			if bfdStatus == "" {
 				bfdStatus = noBFDConfigured
 			}
+			bgpStatus := bgpPeer.BGPState
+			if bgpPeer.AdminShutdown {
+				bgpStatus = adminShutdown
+			}
 			res[vrf][labelFormatForNeighbor(bgpPeer.ID)] = frrk8sv1beta1.BGPSessionStateStatus{
 				Node:      testNodeName,
 				Peer:      labelFormatForNeighbor(bgpPeer.ID),
 				VRF:       vrf,
-				BGPStatus: bgpPeer.BGPState,
+				BGPStatus: bgpStatus,
 				BFDStatus: bfdStatus,
 			}
 		}
@@ -116,7 +120,7 @@ var _ = Describe("BGPSessionState Controller", func() {
 				return fakeBGP.Matches(l)
 			}, 5*time.Second, time.Second).ShouldNot(HaveOccurred())
 
-			By("Updating the first peer's inner BGP and BFD state")
+			By("Updating the first peer's inner BGP and BFD state and shutting the second down")
 			fakeBGP.m = map[string][]*frr.Neighbor{
 				"default": {
 					{
@@ -125,8 +129,9 @@ var _ = Describe("BGPSessionState Controller", func() {
 						BFDStatus: "Up",
 					},
 					{
-						ID:       "192.168.1.2",
-						BGPState: "Active",
+						ID:            "192.168.1.2",
+						BGPState:      "Idle",
+						AdminShutdown: true,
 					},
 					{
 						ID:       "fc00:f853:ccd:e899::",
@@ -182,6 +187,63 @@ var _ = Describe("BGPSessionState Controller", func() {
 				return fakeBGP.Matches(l)
 			}, 5*time.Second, time.Second).ShouldNot(HaveOccurred())
 
+			By("Accepting a dynamic peer from a listen range")
+			fakeBGP.m = map[string][]*frr.Neighbor{
+				"default": {
+					{
+						ID:        "192.168.1.1",
+						BGPState:  "Established",
+						BFDStatus: "Up",
+					},
+					{
+						ID:       "192.168.10.5",
+						BGPState: "Established",
+					},
+				},
+				"red": {
+					{
+						ID:        "192.168.1.1",
+						BGPState:  "Established",
+						BFDStatus: "Up",
+					},
+				},
+			}
+
+			Eventually(func() error {
+				l := frrk8sv1beta1.BGPSessionStateList{}
+				err := k8sClient.List(context.Background(), &l)
+				if err != nil {
+					return err
+				}
+				return fakeBGP.Matches(l)
+			}, 5*time.Second, time.Second).ShouldNot(HaveOccurred())
+
+			By("Removing the dynamic peer once its session goes away")
+			fakeBGP.m = map[string][]*frr.Neighbor{
+				"default": {
+					{
+						ID:        "192.168.1.1",
+						BGPState:  "Established",
+						BFDStatus: "Up",
+					},
+				},
+				"red": {
+					{
+						ID:        "192.168.1.1",
+						BGPState:  "Established",
+						BFDStatus: "Up",
+					},
+				},
+			}
+
+			Eventually(func() error {
+				l := frrk8sv1beta1.BGPSessionStateList{}
+				err := k8sClient.List(context.Background(), &l)
+				if err != nil {
+					return err
+				}
+				return fakeBGP.Matches(l)
+			}, 5*time.Second, time.Second).ShouldNot(HaveOccurred())
 		})
 	})
 })
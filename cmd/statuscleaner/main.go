@@ -171,7 +171,7 @@ func main() {
 	go func() {
 		<-startListeners
 
-		setupWebhook(mgr)
+		setupWebhook(mgr, params.namespace)
 		startNodeStateCleaner(mgr, params.namespace, params.frrk8sSelector, defaultLogLevel)
 	}()
 
@@ -255,16 +255,21 @@ func setupCertRotation(notifyFinished chan struct{}, mgr manager.Manager,
 	return nil
 }
 
-func setupWebhook(mgr manager.Manager) {
+func setupWebhook(mgr manager.Manager, namespace string) {
 	logger := logging.GetLogger()
 	level.Info(logger).Log("op", "startup", "action", "webhooks enabled")
 
 	webhooks.Logger = logger
 	webhooks.WebhookClient = mgr.GetAPIReader()
 	webhooks.Validate = controller.Validate
+	webhooks.Namespace = namespace
 
 	if err := (&webhooks.FRRConfigValidator{}).SetupWebhookWithManager(mgr); err != nil {
 		level.Error(logger).Log("op", "startup", "error", err, "msg", "unable to create webhook", "webhook", "FRRConfigurations")
 		os.Exit(1)
 	}
+	if err := (&webhooks.BGPPeerTemplateValidator{}).SetupWebhookWithManager(mgr); err != nil {
+		level.Error(logger).Log("op", "startup", "error", err, "msg", "unable to create webhook", "webhook", "BGPPeerTemplates")
+		os.Exit(1)
+	}
 }
@@ -5,6 +5,8 @@ package community
 import (
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"strconv"
 	"strings"
 )
@@ -15,10 +17,20 @@ var (
 )
 
 // largeBGPCommunityMarker is the prefix that shall be used to indicate that a given community value is of type large
-// community. If and when extended BGP communities will be supported, the largeBGPCommunityMarker allows us to
-// distinguish between extended and large communities.
+// community. The largeBGPCommunityMarker allows us to distinguish between extended and large communities.
 const largeBGPCommunityMarker = "large"
 
+// frr names of the supported extended community types. Route target and site of origin communities can be parsed,
+// link bandwidth ones are built with NewLinkBandwidth.
+const (
+	routeTargetType   = "rt"
+	siteOfOriginType  = "soo"
+	linkBandwidthType = "bandwidth"
+)
+
+// maxLinkBandwidth is the maximum link bandwidth in Mbps frr accepts.
+const maxLinkBandwidth = 25600
+
 // BGPCommunity represents a BGP community.
 type BGPCommunity interface {
 	LessThan(BGPCommunity) bool
@@ -29,13 +41,16 @@ type BGPCommunity interface {
 // Strings are parsed according to Juniper style  syntax (https://www.juniper.net/documentation/us/en/software/\
 // junos/routing-policy/bgp/topics/concept/policy-bgp-communities-extended-communities-match-conditions-overview.html
 // Legacy communities are of format "<AS number>:<community value>".
-// Extended communities (support of which is yet to be implemented) are of format "<type>:<administrator>:<assigned-number>".
+// Extended communities are of format "<type>:<administrator>:<assigned-number>", where type is either rt (route target)
+// or soo (site of origin) and administrator is either an AS number or an IPv4 address.
 // Large communities are of format large:<global administrator>:<localdata part 1>:<localdata part 2>.
 func New(c string) (BGPCommunity, error) {
 	var bgpCommunity BGPCommunity
 
 	fs := strings.Split(c, ":")
 	switch l := len(fs); l {
+	case 3:
+		return newExtended(c, fs)
 	case 2:
 		var fields [2]uint16
 		for i := 0; i < 2; i++ {
@@ -75,6 +90,44 @@ func New(c string) (BGPCommunity, error) {
 	return bgpCommunity, fmt.Errorf("%w: %s", ErrInvalidCommunityFormat, c)
 }
 
+// newExtended returns the route target or site of origin extended community represented by the given fields. The
+// assigned number is a 32 bits one when the administrator is a 2 bytes AS number, a 16 bits one otherwise.
+func newExtended(c string, fs []string) (BGPCommunity, error) {
+	if fs[0] != routeTargetType && fs[0] != siteOfOriginType {
+		return BGPCommunityExtended{}, fmt.Errorf("%w: invalid type for extended community, expected community to be of "+
+			"format %s|%s:<administrator>:<assigned number> but got %q instead",
+			ErrInvalidCommunityValue, routeTargetType, siteOfOriginType, c)
+	}
+
+	var administrator string
+	assignedNumberBits := 16
+	// the fields can't hold an IPv6 address, as they are separated by colons.
+	if ip := net.ParseIP(fs[1]); ip != nil {
+		administrator = ip.String()
+	} else {
+		asn, err := strconv.ParseUint(fs[1], 10, 32)
+		if err != nil {
+			return BGPCommunityExtended{}, fmt.Errorf("%w: invalid section %q of community %q, err: %q",
+				ErrInvalidCommunityValue, fs[1], c, err)
+		}
+		if asn <= math.MaxUint16 {
+			assignedNumberBits = 32
+		}
+		administrator = strconv.FormatUint(asn, 10)
+	}
+
+	assignedNumber, err := strconv.ParseUint(fs[2], 10, assignedNumberBits)
+	if err != nil {
+		return BGPCommunityExtended{}, fmt.Errorf("%w: invalid section %q of community %q, err: %q",
+			ErrInvalidCommunityValue, fs[2], c, err)
+	}
+
+	return BGPCommunityExtended{
+		extType: fs[0],
+		value:   fmt.Sprintf("%s:%d", administrator, assignedNumber),
+	}, nil
+}
+
 // BGPCommunityLegacy holds the internal representation of a BGP legacy community.
 type BGPCommunityLegacy struct {
 	upperVal uint16
@@ -117,6 +170,51 @@ func (b BGPCommunityLarge) String() string {
 	return fmt.Sprintf("%d:%d:%d", b.globalAdministrator, b.localDataPart1, b.localDataPart2)
 }
 
+// BGPCommunityExtended holds the internal representation of a BGP extended community, as its
+// frr type and value.
+type BGPCommunityExtended struct {
+	extType string
+	value   string
+}
+
+// NewLinkBandwidth returns a link bandwidth extended community carrying the given bandwidth in Mbps.
+func NewLinkBandwidth(mbps uint32) (BGPCommunityExtended, error) {
+	if mbps < 1 || mbps > maxLinkBandwidth {
+		return BGPCommunityExtended{}, fmt.Errorf("%w: link bandwidth %d must be between 1 and %d Mbps",
+			ErrInvalidCommunityValue, mbps, maxLinkBandwidth)
+	}
+	return BGPCommunityExtended{
+		extType: linkBandwidthType,
+		value:   strconv.FormatUint(uint64(mbps), 10),
+	}, nil
+}
+
+// NewLinkBandwidthNumMultipaths returns a link bandwidth extended community carrying the number of
+// multipaths of the route as its bandwidth.
+func NewLinkBandwidthNumMultipaths() BGPCommunityExtended {
+	return BGPCommunityExtended{
+		extType: linkBandwidthType,
+		value:   "num-multipaths",
+	}
+}
+
+// LessThan makes 2 different BGPCommunity objects comparable. Extended communities are considered to be
+// greater than the legacy and large ones, and are compared by type and value.
+func (b BGPCommunityExtended) LessThan(c BGPCommunity) bool {
+	return lessThan(b, c)
+}
+
+// String returns the string representation of this community. Extended communities will be printed as
+// their value, without the type.
+func (b BGPCommunityExtended) String() string {
+	return b.value
+}
+
+// Type returns the type of this extended community, as named by frr.
+func (b BGPCommunityExtended) Type() string {
+	return b.extType
+}
+
 // IsLegacy returns true if this is a Legacy community.
 func IsLegacy(c BGPCommunity) bool {
 	_, ok := c.(BGPCommunityLegacy)
@@ -129,8 +227,23 @@ func IsLarge(c BGPCommunity) bool {
 	return ok
 }
 
+// IsExtended returns true if this is an Extended community.
+func IsExtended(c BGPCommunity) bool {
+	_, ok := c.(BGPCommunityExtended)
+	return ok
+}
+
 // lessThan is a helper function that compares two communities regardless of their type.
 func lessThan(b BGPCommunity, c BGPCommunity) bool {
+	be, bIsExtended := b.(BGPCommunityExtended)
+	ce, cIsExtended := c.(BGPCommunityExtended)
+	switch {
+	case bIsExtended && cIsExtended:
+		return be.extType < ce.extType || (be.extType == ce.extType && be.value < ce.value)
+	case bIsExtended || cIsExtended:
+		return cIsExtended
+	}
+
 	var bl BGPCommunityLarge
 	var cl BGPCommunityLarge
 	switch v := b.(type) {
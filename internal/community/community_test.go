@@ -37,9 +37,37 @@ func TestNewBGPCommunity(t *testing.T) {
 			input:       "large:12345:wrong:12345",
 			errorString: "invalid community value: invalid section",
 		},
-		"invalid extended community not yet implemented": {
+		"valid route target community": {
+			input:  "rt:65000:100",
+			output: BGPCommunityExtended{extType: "rt", value: "65000:100"},
+		},
+		"valid route target community with 4 bytes asn": {
+			input:  "rt:4200000000:100",
+			output: BGPCommunityExtended{extType: "rt", value: "4200000000:100"},
+		},
+		"valid site of origin community with ip": {
+			input:  "soo:192.0.2.1:100",
+			output: BGPCommunityExtended{extType: "soo", value: "192.0.2.1:100"},
+		},
+		"invalid extended community type": {
 			input:       "12345:12345:12345",
-			errorString: "invalid community format: 12345:12345:12345",
+			errorString: "invalid community value: invalid type for extended community",
+		},
+		"invalid extended community ipv6 administrator": {
+			input:       "rt:2001::1:100",
+			errorString: "invalid community format: rt:2001::1:100",
+		},
+		"invalid extended community administrator": {
+			input:       "soo:wrong:100",
+			errorString: "invalid community value: invalid section",
+		},
+		"invalid extended community assigned number with 4 bytes asn": {
+			input:       "rt:4200000000:65536",
+			errorString: "invalid community value: invalid section",
+		},
+		"invalid extended community assigned number with ip": {
+			input:       "soo:192.0.2.1:65536",
+			errorString: "invalid community value: invalid section",
 		},
 	}
 	for d, tc := range tcs {
@@ -85,13 +113,68 @@ func TestBGPCommunityLessThan(t *testing.T) {
 	}
 }
 
+func TestNewLinkBandwidth(t *testing.T) {
+	tcs := map[string]struct {
+		input       uint32
+		output      string
+		errorString string
+	}{
+		"valid link bandwidth": {
+			input:  100,
+			output: "100",
+		},
+		"zero link bandwidth": {
+			input:       0,
+			errorString: "invalid community value: link bandwidth 0 must be between 1 and 25600 Mbps",
+		},
+		"link bandwidth too large": {
+			input:       25601,
+			errorString: "invalid community value: link bandwidth 25601 must be between 1 and 25600 Mbps",
+		},
+	}
+	for d, tc := range tcs {
+		c, err := NewLinkBandwidth(tc.input)
+		if tc.errorString != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.errorString) {
+				t.Fatalf("%s(%s): Expected returned error to contain '%s', but got %v instead",
+					t.Name(), d, tc.errorString, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s(%s): Expected to see no error, but got %q instead", t.Name(), d, err)
+		}
+		if !IsExtended(c) || c.Type() != "bandwidth" || c.String() != tc.output {
+			t.Fatalf("%s(%s): expected link bandwidth %q, got %s %q", t.Name(), d, tc.output, c.Type(), c)
+		}
+	}
+}
+
+func TestBGPCommunityExtendedLessThan(t *testing.T) {
+	legacy, _ := New("0:1234")
+	large, _ := New("large:123:456:789")
+	bandwidth, _ := NewLinkBandwidth(100)
+	numMultipaths := NewLinkBandwidthNumMultipaths()
+
+	if !legacy.LessThan(bandwidth) || bandwidth.LessThan(legacy) {
+		t.Fatalf("expected legacy community %s to be less than extended community %s", legacy, bandwidth)
+	}
+	if !large.LessThan(bandwidth) || bandwidth.LessThan(large) {
+		t.Fatalf("expected large community %s to be less than extended community %s", large, bandwidth)
+	}
+	if !bandwidth.LessThan(numMultipaths) || numMultipaths.LessThan(bandwidth) {
+		t.Fatalf("expected extended community %s to be less than extended community %s", bandwidth, numMultipaths)
+	}
+}
+
 func TestBGPCommunityString(t *testing.T) {
 	tcs := map[string]struct {
 		input  string
 		output string
 	}{
-		"legacy community": {input: "0:1234", output: "0:1234"},
-		"large community":  {input: "large:1:2:3", output: "1:2:3"},
+		"legacy community":   {input: "0:1234", output: "0:1234"},
+		"large community":    {input: "large:1:2:3", output: "1:2:3"},
+		"extended community": {input: "rt:1:2", output: "1:2"},
 	}
 	for d, tc := range tcs {
 		community, _ := New(tc.input)
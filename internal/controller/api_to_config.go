@@ -12,6 +12,7 @@ import (
 	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	v1beta1 "github.com/metallb/frr-k8s/api/v1beta1"
@@ -25,9 +26,16 @@ import (
 	"k8s.io/utils/ptr"
 )
 
+// maxWeight is the highest weight frr accepts for a route.
+const maxWeight = 65535
+
 type ClusterResources struct {
 	FRRConfigs      []v1beta1.FRRConfiguration
 	PasswordSecrets map[string]corev1.Secret
+	PeerTemplates   map[string]v1beta1.BGPPeerTemplate
+	// Node is the node the configuration is rendered for. When nil,
+	// the node is assumed to be ready.
+	Node *corev1.Node
 }
 
 type namedRawConfig struct {
@@ -43,6 +51,7 @@ func apiToFRR(resources ClusterResources, alwaysBlock []net.IPNet) (*frr.Config,
 
 	rawConfigs := make([]namedRawConfig, 0)
 	routersForVRF := map[string]*frr.RouterConfig{}
+	staticRoutes := map[string]frr.StaticRouteConfig{}
 	bfdProfilesAllConfigs := map[string]*frr.BFDProfile{}
 	for _, cfg := range resources.FRRConfigs {
 		bfdProfiles := map[string]*frr.BFDProfile{}
@@ -71,10 +80,25 @@ func apiToFRR(resources ClusterResources, alwaysBlock []net.IPNet) (*frr.Config,
 			}
 		}
 
+		for _, sr := range cfg.Spec.Static.Routes {
+			route, err := staticRouteToFRR(sr, bfdProfiles)
+			if err != nil {
+				return nil, fmt.Errorf("invalid static route %s in config %s: %w", sr.Prefix, cfg.Name, err)
+			}
+			if err := mergeStaticRoute(staticRoutes, route); err != nil {
+				return nil, err
+			}
+		}
+
+		routers, err := routersWithTemplates(cfg.Spec.BGP.Routers, resources.PeerTemplates)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve templates for config %s: %w", cfg.Name, err)
+		}
+
 		alwaysBlockFRR := alwaysBlockToFRR(alwaysBlock)
-		routersPrefixes := prefixesForVRFs(cfg.Spec.BGP.Routers)
+		routersPrefixes := prefixesForVRFs(routers)
 
-		for _, r := range cfg.Spec.BGP.Routers {
+		for _, r := range routers {
 			if err := validatePrefixes(r.Prefixes); err != nil {
 				return nil, err
 			}
@@ -85,6 +109,13 @@ func apiToFRR(resources ClusterResources, alwaysBlock []net.IPNet) (*frr.Config,
 
 			allPrefixes := make([]string, len(r.Prefixes))
 			copy(allPrefixes, r.Prefixes)
+			for _, a := range r.Aggregates {
+				_, cidr, err := net.ParseCIDR(a.Prefix)
+				if err != nil {
+					return nil, fmt.Errorf("invalid aggregate prefix %s for router %d-%s, err: %w", a.Prefix, r.ASN, r.VRF, err)
+				}
+				allPrefixes = append(allPrefixes, cidr.String())
+			}
 
 			importedPrefixes, err := importedPrefixes(r, routersPrefixes)
 			if err != nil {
@@ -96,7 +127,7 @@ func apiToFRR(resources ClusterResources, alwaysBlock []net.IPNet) (*frr.Config,
 				return nil, err
 			}
 
-			routerCfg, err := routerToFRRConfig(r, alwaysBlockFRR, resources.PasswordSecrets, bfdProfiles, allPrefixes)
+			routerCfg, err := routerToFRRConfig(r, alwaysBlockFRR, resources.PasswordSecrets, resources.PeerTemplates, bfdProfiles, allPrefixes)
 			if err != nil {
 				return nil, err
 			}
@@ -120,32 +151,70 @@ func apiToFRR(resources ClusterResources, alwaysBlock []net.IPNet) (*frr.Config,
 		}
 	}
 
+	// The session type of a neighbor depends on the confederation, which
+	// may come from a different configuration of the same router.
+	for _, r := range routersForVRF {
+		if err := validateSessionTypes(r); err != nil {
+			return nil, fmt.Errorf("router %d-%s: %w", r.MyASN, r.VRF, err)
+		}
+	}
+
 	if err := validateEVPN(routersForVRF); err != nil {
 		return nil, err
 	}
 
+	for _, vrf := range heldVRFs(resources) {
+		holdAdvertisements(routersForVRF[vrf])
+	}
+
 	res.Routers = sortMap(routersForVRF)
 	res.ExtraConfig = joinRawConfigs(rawConfigs)
 	res.BFDProfiles = sortMapPtr(bfdProfilesAllConfigs)
+	res.StaticRoutes = sortMap(staticRoutes)
 
 	return res, nil
 }
 
-func routerToFRRConfig(r v1beta1.Router, alwaysBlock []frr.IncomingFilter, secrets map[string]corev1.Secret, bfdProfiles map[string]*frr.BFDProfile, routerPrefixes []string) (*frr.RouterConfig, error) {
+func routerToFRRConfig(r v1beta1.Router, alwaysBlock []frr.IncomingFilter, secrets map[string]corev1.Secret, templates map[string]v1beta1.BGPPeerTemplate, bfdProfiles map[string]*frr.BFDProfile, routerPrefixes []string) (*frr.RouterConfig, error) {
 	res := &frr.RouterConfig{
-		MyASN:        r.ASN,
-		RouterID:     r.ID,
-		VRF:          r.VRF,
-		Neighbors:    make([]*frr.NeighborConfig, 0),
-		IPV4Prefixes: ipfamily.FilterPrefixes(r.Prefixes, ipfamily.IPv4),
-		IPV6Prefixes: ipfamily.FilterPrefixes(r.Prefixes, ipfamily.IPv6),
-		ImportVRFs:   make([]string, 0),
+		MyASN:           r.ASN,
+		RouterID:        r.ID,
+		VRF:             r.VRF,
+		Neighbors:       make([]*frr.NeighborConfig, 0),
+		IPV4Prefixes:    ipfamily.FilterPrefixes(r.Prefixes, ipfamily.IPv4),
+		IPV6Prefixes:    ipfamily.FilterPrefixes(r.Prefixes, ipfamily.IPv6),
+		ListenLimit:     r.ListenLimit,
+		ClusterID:       r.ClusterID,
+		BestPath:        bestPathToFRR(r.BestPath),
+		GracefulRestart: gracefulRestartToFRR(r.GracefulRestart),
+	}
+
+	var err error
+	res.StartupProtection, err = startupProtectionToFRR(r.StartupProtection, r.VRF)
+	if err != nil {
+		return nil, fmt.Errorf("invalid startup protection for router %d-%s: %w", r.ASN, r.VRF, err)
+	}
+
+	if err := validateClusterID(r.ClusterID); err != nil {
+		return nil, fmt.Errorf("invalid cluster id for router %d-%s: %w", r.ASN, r.VRF, err)
+	}
+
+	res.IPV4Aggregates, res.IPV6Aggregates, err = aggregatesToFRR(r.Aggregates)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aggregates for router %d-%s: %w", r.ASN, r.VRF, err)
+	}
+
+	res.Redistribute, err = redistributeToFRR(r.Redistribute, r.VRF)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redistribute for router %d-%s: %w", r.ASN, r.VRF, err)
+	}
+
+	res.Confederation, err = confederationToFRR(r.Confederation, r.ASN)
+	if err != nil {
+		return nil, fmt.Errorf("invalid confederation for router %d-%s: %w", r.ASN, r.VRF, err)
 	}
 
 	for _, n := range r.Neighbors {
-		if n.LocalASN != 0 && n.ASN != 0 && n.ASN == r.ASN {
-			return nil, fmt.Errorf("neighbor %s: localASN is not supported for iBGP sessions (neighbor ASN %d equals router ASN)", neighborName(n), n.ASN)
-		}
 		frrNeigh, err := neighborToFRR(n, routerPrefixes, alwaysBlock, r.VRF, secrets, bfdProfiles)
 		if err != nil {
 			return nil, fmt.Errorf("failed to process neighbor %s for router %d-%s: %w", neighborName(n), r.ASN, r.VRF, err)
@@ -153,8 +222,17 @@ func routerToFRRConfig(r v1beta1.Router, alwaysBlock []frr.IncomingFilter, secre
 		res.Neighbors = append(res.Neighbors, frrNeigh)
 	}
 
-	for _, v := range r.Imports {
-		res.ImportVRFs = append(res.ImportVRFs, v.VRF)
+	for _, lr := range r.ListenRanges {
+		frrNeigh, err := listenRangeToFRR(lr, templates, routerPrefixes, alwaysBlock, r.VRF, secrets, bfdProfiles)
+		if err != nil {
+			return nil, fmt.Errorf("failed to process listen range %s for router %d-%s: %w", lr.Prefix, r.ASN, r.VRF, err)
+		}
+		res.Neighbors = append(res.Neighbors, frrNeigh)
+	}
+
+	res.IPV4Imports, res.IPV6Imports, err = importsToFRR(r.Imports)
+	if err != nil {
+		return nil, fmt.Errorf("invalid imports for router %d-%s: %w", r.ASN, r.VRF, err)
 	}
 
 	res.EVPN = evpnToFRR(r.EVPN)
@@ -171,46 +249,144 @@ func neighborToFRR(n v1beta1.Neighbor, prefixesInRouter []string, alwaysBlock []
 		return nil, fmt.Errorf("neighbor %s has both Address and Interface specified", neighborName(n))
 	}
 
+	neighborFamily, err := addressFamilyForNeighbor(n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find ipfamily for neighbor %s, err: %w", neighborName(n), err)
+	}
+	res := &frr.NeighborConfig{
+		Name:     neighborName(n),
+		Addr:     n.Address,
+		Iface:    n.Interface,
+		IPFamily: neighborFamily,
+	}
+	err = sessionToFRR(res, n, prefixesInRouter, alwaysBlock, routerVRF, passwordSecrets, bfdProfiles)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// listenRangeToFRR converts the given listen range to a peer group neighbor,
+// getting its session settings from the template the range references.
+func listenRangeToFRR(lr v1beta1.ListenRange, templates map[string]v1beta1.BGPPeerTemplate, prefixesInRouter []string, alwaysBlock []frr.IncomingFilter, routerVRF string, passwordSecrets map[string]corev1.Secret, bfdProfiles map[string]*frr.BFDProfile) (*frr.NeighborConfig, error) {
+	_, cidr, err := net.ParseCIDR(lr.Prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid listen range prefix %s, err: %w", lr.Prefix, err)
+	}
+
+	n, err := neighborWithTemplate(v1beta1.Neighbor{ASN: lr.ASN, DynamicASN: lr.DynamicASN, Template: lr.Template}, templates)
+	if err != nil {
+		return nil, err
+	}
+
+	neighborFamily := ipfamily.ForCIDR(cidr)
+	if n.DualStackAddressFamily {
+		neighborFamily = ipfamily.DualStack
+	}
+	res := &frr.NeighborConfig{
+		Name:        fmt.Sprintf("%s@%s", asnFor(n), cidr.String()),
+		Addr:        listenRangePeerGroup(cidr),
+		IPFamily:    neighborFamily,
+		ListenRange: cidr.String(),
+	}
+	err = sessionToFRR(res, n, prefixesInRouter, alwaysBlock, routerVRF, passwordSecrets, bfdProfiles)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// listenRangePeerGroup returns the name of the peer group the sessions
+// accepted from the given subnet belong to.
+func listenRangePeerGroup(cidr *net.IPNet) string {
+	return "listen-" + strings.ReplaceAll(cidr.String(), "/", "-")
+}
+
+// sessionToFRR fills the given neighbor config with the session settings of n,
+// validating them.
+func sessionToFRR(res *frr.NeighborConfig, n v1beta1.Neighbor, prefixesInRouter []string, alwaysBlock []frr.IncomingFilter, routerVRF string, passwordSecrets map[string]corev1.Secret, bfdProfiles map[string]*frr.BFDProfile) error {
 	if _, ok := bfdProfiles[n.BFDProfile]; n.BFDProfile != "" && !ok {
-		return nil, fmt.Errorf("neighbor %s referencing non existing BFDProfile %s", neighborName(n), n.BFDProfile)
+		return fmt.Errorf("neighbor %s referencing non existing BFDProfile %s", res.Name, n.BFDProfile)
 	}
 
 	if n.ASN == 0 && n.DynamicASN == "" {
-		return nil, fmt.Errorf("neighbor %s has no ASN or DynamicASN specified", neighborName(n))
+		return fmt.Errorf("neighbor %s has no ASN or DynamicASN specified", res.Name)
 	}
 
 	if n.ASN != 0 && n.DynamicASN != "" {
-		return nil, fmt.Errorf("neighbor %s has both ASN and DynamicASN specified", neighborName(n))
+		return fmt.Errorf("neighbor %s has both ASN and DynamicASN specified", res.Name)
 	}
 
 	if n.DynamicASN != "" && n.DynamicASN != v1beta1.InternalASNMode && n.DynamicASN != v1beta1.ExternalASNMode {
-		return nil, fmt.Errorf("neighbor %s has invalid DynamicASN %s specified, must be one of %s,%s", neighborName(n), n.DynamicASN, v1beta1.InternalASNMode, v1beta1.ExternalASNMode)
+		return fmt.Errorf("neighbor %s has invalid DynamicASN %s specified, must be one of %s,%s", res.Name, n.DynamicASN, v1beta1.InternalASNMode, v1beta1.ExternalASNMode)
+	}
+
+	res.ASN = asnFor(n)
+	res.LocalASN = n.LocalASN
+	res.SrcAddr = n.SourceAddress
+	res.Port = n.Port
+	res.EBGPMultiHop = n.EBGPMultiHop
+	if n.TTLSecurityHops != nil && n.EBGPMultiHop {
+		return fmt.Errorf("neighbor %s: ttlSecurityHops and ebgpMultiHop are mutually exclusive", res.Name)
+	}
+	res.TTLSecurityHops = n.TTLSecurityHops
+	res.Passive = n.Passive
+	if n.ExtendedNextHop {
+		if err := validateExtendedNextHop(res); err != nil {
+			return fmt.Errorf("neighbor %s: %w", res.Name, err)
+		}
 	}
-
-	neighborFamily, err := addressFamilyForNeighbor(n)
+	res.ExtendedNextHop = n.ExtendedNextHop
+	res.BFDProfile = n.BFDProfile
+	res.GracefulRestart = n.EnableGracefulRestart
+	var err error
+	res.GracefulRestartMode, err = gracefulRestartModeToFRR(n)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find ipfamily for neighbor %s, err: %w", neighborName(n), err)
+		return fmt.Errorf("neighbor %s: %w", res.Name, err)
 	}
-	res := &frr.NeighborConfig{
-		Name:            neighborName(n),
-		ASN:             asnFor(n),
-		LocalASN:        n.LocalASN,
-		SrcAddr:         n.SourceAddress,
-		Addr:            n.Address,
-		Iface:           n.Interface,
-		Port:            n.Port,
-		IPFamily:        neighborFamily,
-		EBGPMultiHop:    n.EBGPMultiHop,
-		BFDProfile:      n.BFDProfile,
-		GracefulRestart: n.EnableGracefulRestart,
-		VRFName:         routerVRF,
-		AlwaysBlock:     alwaysBlock,
-		AddressFamilies: toStringSlice(n.AddressFamilies),
+	if n.ShutdownMessage != "" && !n.Shutdown {
+		return fmt.Errorf("neighbor %s: shutdownMessage requires shutdown to be set", res.Name)
+	}
+	if strings.ContainsAny(n.Description+n.ShutdownMessage, "\r\n") {
+		return fmt.Errorf("neighbor %s: description and shutdownMessage must be a single line", res.Name)
+	}
+	res.Description = n.Description
+	res.Shutdown = n.Shutdown
+	res.ShutdownMessage = n.ShutdownMessage
+	res.RouteReflectorClient = n.RouteReflectorClient
+	if n.AddPath != nil {
+		if n.AddPath.TX != "" && n.AddPath.TX != v1beta1.AddPathTXAll && n.AddPath.TX != v1beta1.AddPathTXBestPathPerAS {
+			return fmt.Errorf("neighbor %s has invalid add path tx %s specified, must be one of %s,%s", res.Name, n.AddPath.TX, v1beta1.AddPathTXAll, v1beta1.AddPathTXBestPathPerAS)
+		}
+		res.AddPathTX = string(n.AddPath.TX)
+		res.AddPathRXDisabled = n.AddPath.RX != nil && !*n.AddPath.RX
 	}
+	if n.AllowASIn != nil {
+		if n.AllowASIn.Count != nil && n.AllowASIn.Origin {
+			return fmt.Errorf("neighbor %s: allowASIn count and origin are mutually exclusive", res.Name)
+		}
+		res.AllowASIn = "3"
+		if n.AllowASIn.Count != nil {
+			res.AllowASIn = strconv.FormatUint(uint64(*n.AllowASIn.Count), 10)
+		}
+		if n.AllowASIn.Origin {
+			res.AllowASIn = "origin"
+		}
+	}
+	res.ASOverride = n.ASOverride
+	if n.RemovePrivateAS != nil {
+		res.RemovePrivateAS = &frr.RemovePrivateAS{
+			All:       n.RemovePrivateAS.All,
+			ReplaceAS: n.RemovePrivateAS.ReplaceAS,
+		}
+	}
+	res.VRFName = routerVRF
+	res.AlwaysBlock = alwaysBlock
+	res.AddressFamilies = toStringSlice(n.AddressFamilies)
 
 	res.HoldTime, res.KeepaliveTime, err = parseTimers(n.HoldTime, n.KeepaliveTime)
 	if err != nil {
-		return nil, fmt.Errorf("invalid timers for neighbor %s, err: %w", neighborName(n), err)
+		return fmt.Errorf("invalid timers for neighbor %s, err: %w", res.Name, err)
 	}
 
 	if n.ConnectTime != nil {
@@ -219,17 +395,21 @@ func neighborToFRR(n v1beta1.Neighbor, prefixesInRouter []string, alwaysBlock []
 
 	res.Password, err = passwordForNeighbor(n, passwordSecrets)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	res.MaxPrefixes, err = maxPrefixesToFRR(res, n.MaxPrefixes)
+	if err != nil {
+		return err
 	}
 	res.Outgoing, err = toAdvertiseToFRR(res, n.ToAdvertise, prefixesInRouter)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	res.Incoming, err = toReceiveToFRR(n.ToReceive)
+	res.Incoming, err = toReceiveToFRR(res, n.ToReceive)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return res, nil
+	return nil
 }
 
 func addressFamilyForNeighbor(n v1beta1.Neighbor) (ipfamily.Family, error) {
@@ -247,6 +427,26 @@ func addressFamilyForNeighbor(n v1beta1.Neighbor) (ipfamily.Family, error) {
 	return neighborFamily, nil
 }
 
+// validateExtendedNextHop checks that the extended next hop capability
+// can be negotiated with the given neighbor: IPv4 prefixes are exchanged
+// with IPv6 next hops only over IPv6 sessions with both families enabled.
+func validateExtendedNextHop(n *frr.NeighborConfig) error {
+	if n.IPFamily != ipfamily.DualStack {
+		return fmt.Errorf("extendedNextHop requires dualStackAddressFamily")
+	}
+	if n.Iface != "" {
+		return nil
+	}
+	sessionFamily := ipfamily.ForCIDRString(n.ListenRange)
+	if n.ListenRange == "" {
+		sessionFamily = ipfamily.ForAddress(net.ParseIP(n.Addr))
+	}
+	if sessionFamily != ipfamily.IPv6 {
+		return fmt.Errorf("extendedNextHop requires an IPv6 session, got %s", sessionFamily)
+	}
+	return nil
+}
+
 func passwordForNeighbor(n v1beta1.Neighbor, passwordSecrets map[string]corev1.Secret) (string, error) {
 	if n.Password != "" && n.PasswordSecret.Name != "" {
 		return "", fmt.Errorf("neighbor %s specifies both cleartext password and secret ref", neighborName(n))
@@ -286,10 +486,15 @@ func toAdvertiseToFRR(neighbor *frr.NeighborConfig, toAdvertise v1beta1.Advertis
 	}
 
 	res := frr.AllowedOut{
-		PrefixesV4:                 make([]string, 0),
-		PrefixesV6:                 make([]string, 0),
-		LocalPrefPrefixesModifiers: make([]frr.LocalPrefPrefixList, 0),
-		CommunityPrefixesModifiers: make([]frr.CommunityPrefixList, 0),
+		PrefixesV4:                     make([]string, 0),
+		PrefixesV6:                     make([]string, 0),
+		LocalPrefPrefixesModifiers:     make([]frr.LocalPrefPrefixList, 0),
+		CommunityPrefixesModifiers:     make([]frr.CommunityPrefixList, 0),
+		ASPathPrependPrefixesModifiers: make([]frr.ASPathPrependPrefixList, 0),
+		MEDPrefixesModifiers:           make([]frr.MEDPrefixList, 0),
+		OriginPrefixesModifiers:        make([]frr.OriginPrefixList, 0),
+		LinkBandwidthPrefixesModifiers: make([]frr.LinkBandwidthPrefixList, 0),
+		Redistributed:                  toAdvertise.Allowed.Mode == v1beta1.AllowRedistributed,
 	}
 
 	if neighborHasIPFamily(neighbor, ipfamily.IPv4) {
@@ -303,11 +508,27 @@ func toAdvertiseToFRR(neighbor *frr.NeighborConfig, toAdvertise v1beta1.Advertis
 	if err != nil {
 		return frr.AllowedOut{}, err
 	}
+	res.DefaultOriginateV4, res.DefaultOriginateV6, err = defaultOriginateToFRR(neighbor, toAdvertise.DefaultOriginate)
+	if err != nil {
+		return frr.AllowedOut{}, err
+	}
+	res.ConditionalV4, res.ConditionalV6, err = conditionalAdvertisementsToFRR(neighbor, toAdvertise.Conditional, prefixesForFamily)
+	if err != nil {
+		return frr.AllowedOut{}, fmt.Errorf("failed to process conditional advertisements for neighbor %s, err: %w", neighbor.Name, err)
+	}
 
 	// map per ip family per local preference
 	localPreferencePrefixLists := map[string]frr.LocalPrefPrefixList{}
 	// map per ip family per community
 	communityPrefixLists := map[string]frr.CommunityPrefixList{}
+	// map per ip family per as path prepend
+	asPathPrependPrefixLists := map[string]frr.ASPathPrependPrefixList{}
+	// map per ip family per med
+	medPrefixLists := map[string]frr.MEDPrefixList{}
+	// map per ip family per origin
+	originPrefixLists := map[string]frr.OriginPrefixList{}
+	// map per ip family per link bandwidth
+	linkBandwidthPrefixLists := map[string]frr.LinkBandwidthPrefixList{}
 
 	for _, ipFamily := range neighborIPFamilies {
 		var err error
@@ -319,9 +540,29 @@ func toAdvertiseToFRR(neighbor *frr.NeighborConfig, toAdvertise v1beta1.Advertis
 		if err != nil {
 			return frr.AllowedOut{}, fmt.Errorf("failed to process local pref for neighbor %s, err: %w", neighbor.Name, err)
 		}
+		asPathPrependPrefixLists, err = prefixesWithASPathPrependToFRR(asPathPrependPrefixLists, neighbor, toAdvertise, ipFamily, prefixesForFamily[ipFamily])
+		if err != nil {
+			return frr.AllowedOut{}, fmt.Errorf("failed to process as path prepend for neighbor %s, err: %w", neighbor.Name, err)
+		}
+		medPrefixLists, err = prefixesWithMEDToFRR(medPrefixLists, neighbor, toAdvertise, ipFamily, prefixesForFamily[ipFamily])
+		if err != nil {
+			return frr.AllowedOut{}, fmt.Errorf("failed to process med for neighbor %s, err: %w", neighbor.Name, err)
+		}
+		originPrefixLists, err = prefixesWithOriginToFRR(originPrefixLists, neighbor, toAdvertise, ipFamily, prefixesForFamily[ipFamily])
+		if err != nil {
+			return frr.AllowedOut{}, fmt.Errorf("failed to process origin for neighbor %s, err: %w", neighbor.Name, err)
+		}
+		linkBandwidthPrefixLists, err = prefixesWithLinkBandwidthToFRR(linkBandwidthPrefixLists, neighbor, toAdvertise, ipFamily, prefixesForFamily[ipFamily])
+		if err != nil {
+			return frr.AllowedOut{}, fmt.Errorf("failed to process link bandwidth for neighbor %s, err: %w", neighbor.Name, err)
+		}
 	}
 	res.LocalPrefPrefixesModifiers = sortMap(localPreferencePrefixLists)
 	res.CommunityPrefixesModifiers = sortMap(communityPrefixLists)
+	res.ASPathPrependPrefixesModifiers = sortMap(asPathPrependPrefixLists)
+	res.MEDPrefixesModifiers = sortMap(medPrefixLists)
+	res.OriginPrefixesModifiers = sortMap(originPrefixLists)
+	res.LinkBandwidthPrefixesModifiers = sortMap(linkBandwidthPrefixLists)
 
 	return res, nil
 }
@@ -352,6 +593,88 @@ func nextHopToFRR(neighbor *frr.NeighborConfig, nextHop v1beta1.NextHop) (string
 	return nextHop.IPv4, nextHop.IPv6, nil
 }
 
+func defaultOriginateToFRR(neighbor *frr.NeighborConfig, defaultOriginate v1beta1.DefaultOriginate) (*frr.DefaultOriginate, *frr.DefaultOriginate, error) {
+	toFRR := func(route *v1beta1.DefaultRoute, family ipfamily.Family) (*frr.DefaultOriginate, error) {
+		if route == nil {
+			return nil, nil
+		}
+		if !neighborHasIPFamily(neighbor, family) {
+			return nil, fmt.Errorf("%s default originate set for neighbor %s without an %s address family", family, neighbor.Name, family)
+		}
+		if route.ConditionPrefix == "" {
+			return &frr.DefaultOriginate{}, nil
+		}
+		_, cidr, err := net.ParseCIDR(route.ConditionPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s default originate condition prefix %q for neighbor %s, err: %w", family, route.ConditionPrefix, neighbor.Name, err)
+		}
+		if ipfamily.ForCIDR(cidr) != family {
+			return nil, fmt.Errorf("%s default originate condition prefix %q for neighbor %s is not %s", family, route.ConditionPrefix, neighbor.Name, family)
+		}
+		return &frr.DefaultOriginate{ConditionPrefix: cidr.String()}, nil
+	}
+
+	v4, err := toFRR(defaultOriginate.IPv4, ipfamily.IPv4)
+	if err != nil {
+		return nil, nil, err
+	}
+	v6, err := toFRR(defaultOriginate.IPv6, ipfamily.IPv6)
+	if err != nil {
+		return nil, nil, err
+	}
+	return v4, v6, nil
+}
+
+func conditionalAdvertisementsToFRR(neighbor *frr.NeighborConfig, conditionals []v1beta1.ConditionalAdvertisement, prefixesForFamily map[ipfamily.Family]sets.Set[string]) (*frr.ConditionalAdvertisement, *frr.ConditionalAdvertisement, error) {
+	res := map[ipfamily.Family]*frr.ConditionalAdvertisement{}
+	for _, c := range conditionals {
+		if len(c.Prefixes) == 0 {
+			return nil, nil, fmt.Errorf("conditional advertisement with no prefixes")
+		}
+		if c.Condition.Type != v1beta1.AdvertisementConditionExist && c.Condition.Type != v1beta1.AdvertisementConditionNonExist {
+			return nil, nil, fmt.Errorf("invalid condition type %s, must be one of %s,%s", c.Condition.Type,
+				v1beta1.AdvertisementConditionExist, v1beta1.AdvertisementConditionNonExist)
+		}
+
+		family := ipfamily.ForCIDRString(c.Prefixes[0])
+		if family == ipfamily.Unknown {
+			return nil, nil, fmt.Errorf("unknown ipfamily for %s", c.Prefixes[0])
+		}
+		if !neighborHasIPFamily(neighbor, family) {
+			return nil, nil, fmt.Errorf("%s conditional advertisement set without an %s address family", family, family)
+		}
+		if _, ok := res[family]; ok {
+			return nil, nil, fmt.Errorf("multiple %s conditional advertisements specified", family)
+		}
+
+		prefixes := sets.New[string]()
+		for _, p := range c.Prefixes {
+			if ipfamily.ForCIDRString(p) != family {
+				return nil, nil, fmt.Errorf("conditional prefixes %s belong to different ip families", c.Prefixes)
+			}
+			if !prefixesForFamily[family].Has(p) {
+				return nil, nil, fmt.Errorf("conditional advertisement associated to non existing prefix %s", p)
+			}
+			prefixes.Insert(p)
+		}
+
+		condition, err := filterForSelector(c.Condition.Selector)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid condition selector, err: %w", err)
+		}
+		if condition.IPFamily != family {
+			return nil, nil, fmt.Errorf("condition selector %s is not %s", c.Condition.Selector.Prefix, family)
+		}
+
+		res[family] = &frr.ConditionalAdvertisement{
+			Prefixes:        sets.List(prefixes),
+			Condition:       string(c.Condition.Type),
+			ConditionPrefix: condition,
+		}
+	}
+	return res[ipfamily.IPv4], res[ipfamily.IPv6], nil
+}
+
 func prefixesWithLocalPrefToFRR(toAdd map[string]frr.LocalPrefPrefixList, neighbor *frr.NeighborConfig, toAdvertise v1beta1.Advertise, ipFamily ipfamily.Family, routerPrefixes sets.Set[string]) (map[string]frr.LocalPrefPrefixList, error) {
 	frrFamily := frrIPFamily(ipFamily)
 	for _, prefixes := range toAdvertise.PrefixesWithLocalPref {
@@ -429,85 +752,651 @@ func prefixesWithCommunityToFRR(toAdd map[string]frr.CommunityPrefixList, neighb
 	return toAdd, nil
 }
 
-func neighborHasIPFamily(neighbor *frr.NeighborConfig, ipFamily ipfamily.Family) bool {
-	if neighbor.IPFamily == ipfamily.DualStack {
-		return true
-	}
-	return neighbor.IPFamily == ipFamily
-}
+func prefixesWithASPathPrependToFRR(toAdd map[string]frr.ASPathPrependPrefixList, neighbor *frr.NeighborConfig, toAdvertise v1beta1.Advertise, ipFamily ipfamily.Family, routerPrefixes sets.Set[string]) (map[string]frr.ASPathPrependPrefixList, error) {
+	frrFamily := frrIPFamily(ipFamily)
+	for _, prefixes := range toAdvertise.PrefixesWithASPathPrepend {
+		repeat := asPathPrependRepeat(prefixes.Repeat)
+		key := asPathPrependPrefixListKey(prefixes.ASN, repeat, frrFamily)
 
-func prefixesToAdvertiseForFamily(toAdvertise v1beta1.Advertise, prefixesInRouter []string, ipFamily ipfamily.Family) sets.Set[string] {
-	res := sets.New[string]()
-	prefixesForFamily := ipfamily.FilterPrefixes(prefixesInRouter, ipFamily)
-	if toAdvertise.Allowed.Mode == v1beta1.AllowAll {
-		for _, p := range prefixesForFamily {
-			res.Insert(p)
+		if _, ok := toAdd[key]; ok {
+			return nil, fmt.Errorf("as path prepend %d x%d is already defined", prefixes.ASN, repeat)
 		}
-		return res
-	}
 
-	for _, p := range toAdvertise.Allowed.Prefixes {
-		prefixFamily := ipfamily.ForCIDRString(p)
-		if prefixFamily == ipFamily {
-			res.Insert(p)
+		asPathPrependPrefixList := frr.ASPathPrependPrefixList{
+			PrefixList: frr.PrefixList{
+				Name:     asPathPrependPrefixListName(neighbor.ID(), prefixes.ASN, repeat, frrFamily),
+				IPFamily: frrFamily,
+				Prefixes: sets.New[string](),
+			},
+			ASN:    prefixes.ASN,
+			Repeat: repeat,
 		}
-	}
-	return res
-}
 
-func frrIPFamily(ipFamily ipfamily.Family) string {
-	if ipFamily == ipfamily.IPv6 {
-		return "ipv6"
+		ipfamilyPrefixes := ipfamily.FilterPrefixes(prefixes.Prefixes, ipFamily)
+		if len(ipfamilyPrefixes) == 0 {
+			continue
+		}
+		for _, prefix := range ipfamilyPrefixes {
+			if !routerPrefixes.Has(prefix) {
+				return nil, fmt.Errorf("as path prepend %d x%d associated to non existing prefix %s", prefixes.ASN, repeat, prefix)
+			}
+			if asPathPrependPrefixList.Prefixes.Has(prefix) {
+				return nil, fmt.Errorf("prefix %s is already defined for as path prepend %d x%d", prefix, prefixes.ASN, repeat)
+			}
+			asPathPrependPrefixList.Prefixes.Insert(prefix)
+		}
+		toAdd[key] = asPathPrependPrefixList
 	}
-	return "ip"
+	return toAdd, nil
 }
 
-func localPrefPrefixListName(neighborID string, localPreference uint32, ipFamily string) string {
-	return fmt.Sprintf("%s-%d-%s-localpref-prefixes", neighborID, localPreference, ipFamily)
-}
+func prefixesWithMEDToFRR(toAdd map[string]frr.MEDPrefixList, neighbor *frr.NeighborConfig, toAdvertise v1beta1.Advertise, ipFamily ipfamily.Family, routerPrefixes sets.Set[string]) (map[string]frr.MEDPrefixList, error) {
+	frrFamily := frrIPFamily(ipFamily)
+	for _, prefixes := range toAdvertise.PrefixesWithMED {
+		key := medPrefixListKey(prefixes.MED, frrFamily)
 
-func communityPrefixListName(neighborID string, comm community.BGPCommunity, ipFamily string) string {
-	if community.IsLarge(comm) {
-		return fmt.Sprintf("%s-large:%s-%s-community-prefixes", neighborID, comm, ipFamily)
-	}
-	return fmt.Sprintf("%s-%s-%s-community-prefixes", neighborID, comm, ipFamily)
-}
+		if _, ok := toAdd[key]; ok {
+			return nil, fmt.Errorf("med %d is already defined", prefixes.MED)
+		}
 
-func communityPrefixListKey(comm community.BGPCommunity, frrAddressFamily string) string {
-	return fmt.Sprintf("%s-%s", comm, frrAddressFamily)
-}
+		medPrefixList := frr.MEDPrefixList{
+			PrefixList: frr.PrefixList{
+				Name:     medPrefixListName(neighbor.ID(), prefixes.MED, frrFamily),
+				IPFamily: frrFamily,
+				Prefixes: sets.New[string](),
+			},
+			MED: prefixes.MED,
+		}
 
-func localPrefPrefixListKey(localPref uint32, frrAddressFamily string) string {
-	return fmt.Sprintf("%d-%s", localPref, frrAddressFamily)
+		ipfamilyPrefixes := ipfamily.FilterPrefixes(prefixes.Prefixes, ipFamily)
+		if len(ipfamilyPrefixes) == 0 {
+			continue
+		}
+		for _, prefix := range ipfamilyPrefixes {
+			if !routerPrefixes.Has(prefix) {
+				return nil, fmt.Errorf("med %d associated to non existing prefix %s", prefixes.MED, prefix)
+			}
+			if medPrefixList.Prefixes.Has(prefix) {
+				return nil, fmt.Errorf("prefix %s is already defined for med %d", prefix, prefixes.MED)
+			}
+			medPrefixList.Prefixes.Insert(prefix)
+		}
+		toAdd[key] = medPrefixList
+	}
+	return toAdd, nil
 }
 
-func toReceiveToFRR(toReceive v1beta1.Receive) (frr.AllowedIn, error) {
-	res := frr.AllowedIn{
-		PrefixesV4: make([]frr.IncomingFilter, 0),
-		PrefixesV6: make([]frr.IncomingFilter, 0),
-	}
-	if toReceive.Allowed.Mode == v1beta1.AllowAll {
-		res.All = true
-		return res, nil
-	}
-	for _, s := range toReceive.Allowed.Prefixes {
-		filter, err := filterForSelector(s)
+func prefixesWithLinkBandwidthToFRR(toAdd map[string]frr.LinkBandwidthPrefixList, neighbor *frr.NeighborConfig, toAdvertise v1beta1.Advertise, ipFamily ipfamily.Family, routerPrefixes sets.Set[string]) (map[string]frr.LinkBandwidthPrefixList, error) {
+	frrFamily := frrIPFamily(ipFamily)
+	for _, prefixes := range toAdvertise.PrefixesWithLinkBandwidth {
+		bandwidth, err := linkBandwidthFor(prefixes)
 		if err != nil {
-			return frr.AllowedIn{}, err
+			return nil, err
 		}
-		if filter.IPFamily == ipfamily.IPv4 {
-			res.PrefixesV4 = append(res.PrefixesV4, filter)
+		key := linkBandwidthPrefixListKey(bandwidth, frrFamily)
+
+		if _, ok := toAdd[key]; ok {
+			return nil, fmt.Errorf("link bandwidth %s is already defined", bandwidth)
+		}
+
+		linkBandwidthPrefixList := frr.LinkBandwidthPrefixList{
+			PrefixList: frr.PrefixList{
+				Name:     linkBandwidthPrefixListName(neighbor.ID(), bandwidth, frrFamily),
+				IPFamily: frrFamily,
+				Prefixes: sets.New[string](),
+			},
+			LinkBandwidth: bandwidth,
+		}
+
+		ipfamilyPrefixes := ipfamily.FilterPrefixes(prefixes.Prefixes, ipFamily)
+		if len(ipfamilyPrefixes) == 0 {
 			continue
 		}
-		res.PrefixesV6 = append(res.PrefixesV6, filter)
+		for _, prefix := range ipfamilyPrefixes {
+			if !routerPrefixes.Has(prefix) {
+				return nil, fmt.Errorf("link bandwidth %s associated to non existing prefix %s", bandwidth, prefix)
+			}
+			if linkBandwidthPrefixList.Prefixes.Has(prefix) {
+				return nil, fmt.Errorf("prefix %s is already defined for link bandwidth %s", prefix, bandwidth)
+			}
+			linkBandwidthPrefixList.Prefixes.Insert(prefix)
+		}
+		toAdd[key] = linkBandwidthPrefixList
 	}
-	sort.Slice(res.PrefixesV4, func(i, j int) bool {
-		return res.PrefixesV4[i].LessThan(res.PrefixesV4[j])
-	})
-	sort.Slice(res.PrefixesV6, func(i, j int) bool {
-		return res.PrefixesV6[i].LessThan(res.PrefixesV6[j])
-	})
-	return res, nil
+	return toAdd, nil
+}
+
+// linkBandwidthFor returns the link bandwidth extended community
+// the given prefixes are associated to.
+func linkBandwidthFor(prefixes v1beta1.LinkBandwidthPrefixes) (community.BGPCommunityExtended, error) {
+	if prefixes.Bandwidth != nil && prefixes.NumMultipaths {
+		return community.BGPCommunityExtended{}, fmt.Errorf("link bandwidth bandwidth and numMultipaths are mutually exclusive")
+	}
+	if prefixes.NumMultipaths {
+		return community.NewLinkBandwidthNumMultipaths(), nil
+	}
+	if prefixes.Bandwidth == nil {
+		return community.BGPCommunityExtended{}, fmt.Errorf("link bandwidth with neither bandwidth nor numMultipaths specified")
+	}
+	return community.NewLinkBandwidth(*prefixes.Bandwidth)
+}
+
+func prefixesWithOriginToFRR(toAdd map[string]frr.OriginPrefixList, neighbor *frr.NeighborConfig, toAdvertise v1beta1.Advertise, ipFamily ipfamily.Family, routerPrefixes sets.Set[string]) (map[string]frr.OriginPrefixList, error) {
+	frrFamily := frrIPFamily(ipFamily)
+	for _, prefixes := range toAdvertise.PrefixesWithOrigin {
+		if err := validateOrigin(prefixes.Origin); err != nil {
+			return nil, err
+		}
+		origin := string(prefixes.Origin)
+		key := originPrefixListKey(origin, frrFamily)
+
+		if _, ok := toAdd[key]; ok {
+			return nil, fmt.Errorf("origin %s is already defined", origin)
+		}
+
+		originPrefixList := frr.OriginPrefixList{
+			PrefixList: frr.PrefixList{
+				Name:     originPrefixListName(neighbor.ID(), origin, frrFamily),
+				IPFamily: frrFamily,
+				Prefixes: sets.New[string](),
+			},
+			Origin: origin,
+		}
+
+		ipfamilyPrefixes := ipfamily.FilterPrefixes(prefixes.Prefixes, ipFamily)
+		if len(ipfamilyPrefixes) == 0 {
+			continue
+		}
+		for _, prefix := range ipfamilyPrefixes {
+			if !routerPrefixes.Has(prefix) {
+				return nil, fmt.Errorf("origin %s associated to non existing prefix %s", origin, prefix)
+			}
+			if originPrefixList.Prefixes.Has(prefix) {
+				return nil, fmt.Errorf("prefix %s is already defined for origin %s", prefix, origin)
+			}
+			originPrefixList.Prefixes.Insert(prefix)
+		}
+		toAdd[key] = originPrefixList
+	}
+	return toAdd, nil
+}
+
+func validateOrigin(origin v1beta1.BGPOrigin) error {
+	switch origin {
+	case v1beta1.OriginIGP, v1beta1.OriginEGP, v1beta1.OriginIncomplete:
+		return nil
+	}
+	return fmt.Errorf("invalid origin %q, must be one of %s,%s,%s", origin, v1beta1.OriginIGP, v1beta1.OriginEGP, v1beta1.OriginIncomplete)
+}
+
+// asPathPrependRepeat returns the number of times the ASN must be prepended,
+// defaulting to one when not set.
+func asPathPrependRepeat(repeat uint32) uint32 {
+	if repeat == 0 {
+		return 1
+	}
+	return repeat
+}
+
+func maxPrefixesToFRR(neighbor *frr.NeighborConfig, maxPrefixes *v1beta1.MaxPrefixes) (*frr.MaxPrefixes, error) {
+	if maxPrefixes == nil {
+		return nil, nil
+	}
+	if maxPrefixes.IPv4 == nil && maxPrefixes.IPv6 == nil {
+		return nil, fmt.Errorf("max prefixes set for neighbor %s without any ipv4 or ipv6 limit", neighbor.Name)
+	}
+	if maxPrefixes.IPv4 != nil && !neighborHasIPFamily(neighbor, ipfamily.IPv4) {
+		return nil, fmt.Errorf("ipv4 max prefixes set for neighbor %s without an ipv4 address family", neighbor.Name)
+	}
+	if maxPrefixes.IPv6 != nil && !neighborHasIPFamily(neighbor, ipfamily.IPv6) {
+		return nil, fmt.Errorf("ipv6 max prefixes set for neighbor %s without an ipv6 address family", neighbor.Name)
+	}
+	if (maxPrefixes.IPv4 != nil && *maxPrefixes.IPv4 == 0) || (maxPrefixes.IPv6 != nil && *maxPrefixes.IPv6 == 0) {
+		return nil, fmt.Errorf("invalid max prefixes for neighbor %s, must be greater than 0", neighbor.Name)
+	}
+
+	res := &frr.MaxPrefixes{
+		IPv4:        maxPrefixes.IPv4,
+		IPv6:        maxPrefixes.IPv6,
+		WarningOnly: maxPrefixes.WarningOnly,
+	}
+	if maxPrefixes.RestartInterval == nil {
+		return res, nil
+	}
+	if maxPrefixes.WarningOnly {
+		return nil, fmt.Errorf("max prefixes for neighbor %s can't have both warningOnly and restartInterval set", neighbor.Name)
+	}
+	interval := maxPrefixes.RestartInterval.Duration
+	if interval%time.Minute != 0 || interval < time.Minute || interval > 65535*time.Minute {
+		return nil, fmt.Errorf("invalid max prefixes restart interval %s for neighbor %s, must be whole minutes between 1m and 65535m", interval, neighbor.Name)
+	}
+	res.RestartInterval = ptr.To(uint32(interval / time.Minute))
+	return res, nil
+}
+
+func neighborHasIPFamily(neighbor *frr.NeighborConfig, ipFamily ipfamily.Family) bool {
+	if neighbor.IPFamily == ipfamily.DualStack {
+		return true
+	}
+	return neighbor.IPFamily == ipFamily
+}
+
+func prefixesToAdvertiseForFamily(toAdvertise v1beta1.Advertise, prefixesInRouter []string, ipFamily ipfamily.Family) sets.Set[string] {
+	res := sets.New[string]()
+	prefixesForFamily := ipfamily.FilterPrefixes(prefixesInRouter, ipFamily)
+	if toAdvertise.Allowed.Mode == v1beta1.AllowAll || toAdvertise.Allowed.Mode == v1beta1.AllowRedistributed {
+		for _, p := range prefixesForFamily {
+			res.Insert(p)
+		}
+		return res
+	}
+
+	for _, p := range toAdvertise.Allowed.Prefixes {
+		prefixFamily := ipfamily.ForCIDRString(p)
+		if prefixFamily == ipFamily {
+			res.Insert(p)
+		}
+	}
+	return res
+}
+
+func frrIPFamily(ipFamily ipfamily.Family) string {
+	if ipFamily == ipfamily.IPv6 {
+		return "ipv6"
+	}
+	return "ip"
+}
+
+func localPrefPrefixListName(neighborID string, localPreference uint32, ipFamily string) string {
+	return fmt.Sprintf("%s-%d-%s-localpref-prefixes", neighborID, localPreference, ipFamily)
+}
+
+func communityPrefixListName(neighborID string, comm community.BGPCommunity, ipFamily string) string {
+	return fmt.Sprintf("%s-%s-%s-community-prefixes", neighborID, communityName(comm), ipFamily)
+}
+
+// communityName returns the given community prefixed by its type when it is
+// not a legacy one, so that communities with the same value but of different
+// types are told apart.
+func communityName(comm community.BGPCommunity) string {
+	switch c := comm.(type) {
+	case community.BGPCommunityLarge:
+		return fmt.Sprintf("large:%s", c)
+	case community.BGPCommunityExtended:
+		return fmt.Sprintf("%s:%s", c.Type(), c)
+	}
+	return comm.String()
+}
+
+func asPathPrependPrefixListName(neighborID string, asn, repeat uint32, ipFamily string) string {
+	return fmt.Sprintf("%s-%dx%d-%s-aspathprepend-prefixes", neighborID, asn, repeat, ipFamily)
+}
+
+func medPrefixListName(neighborID string, med uint32, ipFamily string) string {
+	return fmt.Sprintf("%s-%d-%s-med-prefixes", neighborID, med, ipFamily)
+}
+
+func linkBandwidthPrefixListName(neighborID string, bandwidth community.BGPCommunityExtended, ipFamily string) string {
+	return fmt.Sprintf("%s-%s-%s-linkbandwidth-prefixes", neighborID, bandwidth, ipFamily)
+}
+
+func originPrefixListName(neighborID string, origin string, ipFamily string) string {
+	return fmt.Sprintf("%s-%s-%s-origin-prefixes", neighborID, origin, ipFamily)
+}
+
+func incomingLocalPrefPrefixListName(neighborID string, localPreference uint32, ipFamily string) string {
+	return fmt.Sprintf("%s-%d-%s-in-localpref-prefixes", neighborID, localPreference, ipFamily)
+}
+
+func incomingCommunityPrefixListName(neighborID string, comm community.BGPCommunity, ipFamily string) string {
+	return fmt.Sprintf("%s-%s-%s-in-community-prefixes", neighborID, communityName(comm), ipFamily)
+}
+
+func incomingWeightPrefixListName(neighborID string, weight uint32, ipFamily string) string {
+	return fmt.Sprintf("%s-%d-%s-in-weight-prefixes", neighborID, weight, ipFamily)
+}
+
+func weightPrefixListKey(weight uint32, frrAddressFamily string) string {
+	return fmt.Sprintf("%d-%s", weight, frrAddressFamily)
+}
+
+func asPathPrependPrefixListKey(asn, repeat uint32, frrAddressFamily string) string {
+	return fmt.Sprintf("%dx%d-%s", asn, repeat, frrAddressFamily)
+}
+
+func medPrefixListKey(med uint32, frrAddressFamily string) string {
+	return fmt.Sprintf("%d-%s", med, frrAddressFamily)
+}
+
+func linkBandwidthPrefixListKey(bandwidth community.BGPCommunityExtended, frrAddressFamily string) string {
+	return fmt.Sprintf("%s-%s", bandwidth, frrAddressFamily)
+}
+
+func originPrefixListKey(origin string, frrAddressFamily string) string {
+	return fmt.Sprintf("%s-%s", origin, frrAddressFamily)
+}
+
+func communityPrefixListKey(comm community.BGPCommunity, frrAddressFamily string) string {
+	return fmt.Sprintf("%s-%s", communityName(comm), frrAddressFamily)
+}
+
+func localPrefPrefixListKey(localPref uint32, frrAddressFamily string) string {
+	return fmt.Sprintf("%d-%s", localPref, frrAddressFamily)
+}
+
+func toReceiveToFRR(neighbor *frr.NeighborConfig, toReceive v1beta1.Receive) (frr.AllowedIn, error) {
+	res := frr.AllowedIn{
+		PrefixesV4:                 make([]frr.IncomingFilter, 0),
+		PrefixesV6:                 make([]frr.IncomingFilter, 0),
+		LocalPrefPrefixesModifiers: make([]frr.LocalPrefPrefixList, 0),
+		CommunityPrefixesModifiers: make([]frr.CommunityPrefixList, 0),
+		WeightPrefixesModifiers:    make([]frr.WeightPrefixList, 0),
+	}
+
+	var err error
+	res.LocalPrefPrefixesModifiers, err = incomingPrefixesWithLocalPrefToFRR(neighbor, toReceive)
+	if err != nil {
+		return frr.AllowedIn{}, fmt.Errorf("failed to process incoming local pref for neighbor %s, err: %w", neighbor.Name, err)
+	}
+	res.CommunityPrefixesModifiers, err = incomingPrefixesWithCommunityToFRR(neighbor, toReceive)
+	if err != nil {
+		return frr.AllowedIn{}, fmt.Errorf("failed to process incoming community for neighbor %s, err: %w", neighbor.Name, err)
+	}
+	res.WeightPrefixesModifiers, err = incomingPrefixesWithWeightToFRR(neighbor, toReceive)
+	if err != nil {
+		return frr.AllowedIn{}, fmt.Errorf("failed to process incoming weight for neighbor %s, err: %w", neighbor.Name, err)
+	}
+	err = validateIncomingModifiers(res)
+	if err != nil {
+		return frr.AllowedIn{}, err
+	}
+
+	if toReceive.Allowed.Mode == v1beta1.AllowRedistributed {
+		return frr.AllowedIn{}, fmt.Errorf("mode %s is not supported for received prefixes of neighbor %s", toReceive.Allowed.Mode, neighbor.Name)
+	}
+	if toReceive.Allowed.Mode == v1beta1.AllowAll {
+		if len(toReceive.Allowed.Communities) > 0 || len(toReceive.Allowed.ASPaths) > 0 {
+			return frr.AllowedIn{}, fmt.Errorf("communities and as paths can't be allowed with mode %s for received prefixes of neighbor %s", toReceive.Allowed.Mode, neighbor.Name)
+		}
+		res.All = true
+		return res, nil
+	}
+	for _, s := range toReceive.Allowed.Prefixes {
+		filter, err := filterForSelector(s)
+		if err != nil {
+			return frr.AllowedIn{}, err
+		}
+		if filter.IPFamily == ipfamily.IPv4 {
+			res.PrefixesV4 = append(res.PrefixesV4, filter)
+			continue
+		}
+		res.PrefixesV6 = append(res.PrefixesV6, filter)
+	}
+	sort.Slice(res.PrefixesV4, func(i, j int) bool {
+		return res.PrefixesV4[i].LessThan(res.PrefixesV4[j])
+	})
+	sort.Slice(res.PrefixesV6, func(i, j int) bool {
+		return res.PrefixesV6[i].LessThan(res.PrefixesV6[j])
+	})
+	err = validateIncomingModifiersAllowed(toReceive, res.AllPrefixes())
+	if err != nil {
+		return frr.AllowedIn{}, fmt.Errorf("invalid incoming modifiers for neighbor %s, err: %w", neighbor.Name, err)
+	}
+
+	res.Communities, res.LargeCommunities, err = incomingCommunitiesToFRR(toReceive.Allowed.Communities)
+	if err != nil {
+		return frr.AllowedIn{}, fmt.Errorf("failed to process allowed communities for neighbor %s, err: %w", neighbor.Name, err)
+	}
+	res.ASPaths, err = incomingASPathsToFRR(toReceive.Allowed.ASPaths)
+	if err != nil {
+		return frr.AllowedIn{}, fmt.Errorf("failed to process allowed as paths for neighbor %s, err: %w", neighbor.Name, err)
+	}
+	return res, nil
+}
+
+// incomingCommunitiesToFRR validates the given communities and splits them
+// into legacy and large ones, as frr handles them with different lists.
+func incomingCommunitiesToFRR(communities []string) ([]string, []string, error) {
+	legacy := sets.New[string]()
+	large := sets.New[string]()
+	for _, c := range communities {
+		parsed, err := community.New(c)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid community %s, err: %w", c, err)
+		}
+		if community.IsExtended(parsed) {
+			return nil, nil, fmt.Errorf("extended community %s can't be used to filter the received routes", c)
+		}
+		if community.IsLarge(parsed) {
+			large.Insert(parsed.String())
+			continue
+		}
+		legacy.Insert(parsed.String())
+	}
+	return sets.List(legacy), sets.List(large), nil
+}
+
+func incomingASPathsToFRR(asPaths []string) ([]string, error) {
+	res := sets.New[string]()
+	for _, a := range asPaths {
+		if strings.TrimSpace(a) == "" {
+			return nil, fmt.Errorf("empty as path regular expression")
+		}
+		if strings.ContainsAny(a, "\n\r") {
+			return nil, fmt.Errorf("invalid as path regular expression %q", a)
+		}
+		res.Insert(a)
+	}
+	return sets.List(res), nil
+}
+
+func incomingPrefixesWithLocalPrefToFRR(neighbor *frr.NeighborConfig, toReceive v1beta1.Receive) ([]frr.LocalPrefPrefixList, error) {
+	// map per ip family per local preference
+	toAdd := map[string]frr.LocalPrefPrefixList{}
+	localPrefs := sets.New[uint32]()
+	for _, selectors := range toReceive.PrefixesWithLocalPref {
+		if localPrefs.Has(selectors.LocalPref) {
+			return nil, fmt.Errorf("local preference %d is already defined", selectors.LocalPref)
+		}
+		localPrefs.Insert(selectors.LocalPref)
+		for _, s := range selectors.Prefixes {
+			filter, err := filterForSelector(s)
+			if err != nil {
+				return nil, err
+			}
+			frrFamily := frrIPFamily(filter.IPFamily)
+			key := localPrefPrefixListKey(selectors.LocalPref, frrFamily)
+			prefixList, ok := toAdd[key]
+			if !ok {
+				prefixList = frr.LocalPrefPrefixList{
+					PrefixList: frr.PrefixList{
+						Name:     incomingLocalPrefPrefixListName(neighbor.ID(), selectors.LocalPref, frrFamily),
+						IPFamily: frrFamily,
+						Prefixes: sets.New[string](),
+					},
+					LocalPref: selectors.LocalPref,
+				}
+			}
+			entry := filter.Prefix + filter.Matcher()
+			if prefixList.Prefixes.Has(entry) {
+				return nil, fmt.Errorf("prefix %s is already defined for local preference %d", entry, selectors.LocalPref)
+			}
+			prefixList.Prefixes.Insert(entry)
+			toAdd[key] = prefixList
+		}
+	}
+	return sortMap(toAdd), nil
+}
+
+func incomingPrefixesWithCommunityToFRR(neighbor *frr.NeighborConfig, toReceive v1beta1.Receive) ([]frr.CommunityPrefixList, error) {
+	// map per ip family per community
+	toAdd := map[string]frr.CommunityPrefixList{}
+	communities := sets.New[string]()
+	for _, selectors := range toReceive.PrefixesWithCommunity {
+		c, err := community.New(selectors.Community)
+		if err != nil {
+			return nil, fmt.Errorf("invalid community %s, err: %w", selectors.Community, err)
+		}
+		if communities.Has(communityName(c)) {
+			return nil, fmt.Errorf("community %s is already defined", selectors.Community)
+		}
+		communities.Insert(communityName(c))
+		for _, s := range selectors.Prefixes {
+			filter, err := filterForSelector(s)
+			if err != nil {
+				return nil, err
+			}
+			frrFamily := frrIPFamily(filter.IPFamily)
+			key := communityPrefixListKey(c, frrFamily)
+			prefixList, ok := toAdd[key]
+			if !ok {
+				prefixList = frr.CommunityPrefixList{
+					PrefixList: frr.PrefixList{
+						Name:     incomingCommunityPrefixListName(neighbor.ID(), c, frrFamily),
+						IPFamily: frrFamily,
+						Prefixes: sets.New[string](),
+					},
+					Community: c,
+				}
+			}
+			entry := filter.Prefix + filter.Matcher()
+			if prefixList.Prefixes.Has(entry) {
+				return nil, fmt.Errorf("prefix %s is already defined for community %s", entry, c)
+			}
+			prefixList.Prefixes.Insert(entry)
+			toAdd[key] = prefixList
+		}
+	}
+	return sortMap(toAdd), nil
+}
+
+func incomingPrefixesWithWeightToFRR(neighbor *frr.NeighborConfig, toReceive v1beta1.Receive) ([]frr.WeightPrefixList, error) {
+	// map per ip family per weight
+	toAdd := map[string]frr.WeightPrefixList{}
+	weights := sets.New[uint32]()
+	for _, selectors := range toReceive.PrefixesWithWeight {
+		if selectors.Weight > maxWeight {
+			return nil, fmt.Errorf("invalid weight %d, must be lower or equal than %d", selectors.Weight, maxWeight)
+		}
+		if weights.Has(selectors.Weight) {
+			return nil, fmt.Errorf("weight %d is already defined", selectors.Weight)
+		}
+		weights.Insert(selectors.Weight)
+		for _, s := range selectors.Prefixes {
+			filter, err := filterForSelector(s)
+			if err != nil {
+				return nil, err
+			}
+			frrFamily := frrIPFamily(filter.IPFamily)
+			key := weightPrefixListKey(selectors.Weight, frrFamily)
+			prefixList, ok := toAdd[key]
+			if !ok {
+				prefixList = frr.WeightPrefixList{
+					PrefixList: frr.PrefixList{
+						Name:     incomingWeightPrefixListName(neighbor.ID(), selectors.Weight, frrFamily),
+						IPFamily: frrFamily,
+						Prefixes: sets.New[string](),
+					},
+					Weight: selectors.Weight,
+				}
+			}
+			entry := filter.Prefix + filter.Matcher()
+			if prefixList.Prefixes.Has(entry) {
+				return nil, fmt.Errorf("prefix %s is already defined for weight %d", entry, selectors.Weight)
+			}
+			prefixList.Prefixes.Insert(entry)
+			toAdd[key] = prefixList
+		}
+	}
+	return sortMap(toAdd), nil
+}
+
+// validateIncomingModifiers checks that the same prefix selector is not
+// associated to different values of the same property.
+func validateIncomingModifiers(allowed frr.AllowedIn) error {
+	localPrefForPrefix := map[string]uint32{}
+	for _, p := range allowed.LocalPrefPrefixesModifiers {
+		for _, prefix := range p.SortedPrefixes() {
+			if existing, ok := localPrefForPrefix[prefix]; ok {
+				return fmt.Errorf("incoming prefix %s is configured with both local pref %d and %d", prefix, existing, p.LocalPref)
+			}
+			localPrefForPrefix[prefix] = p.LocalPref
+		}
+	}
+	weightForPrefix := map[string]uint32{}
+	for _, p := range allowed.WeightPrefixesModifiers {
+		for _, prefix := range p.SortedPrefixes() {
+			if existing, ok := weightForPrefix[prefix]; ok {
+				return fmt.Errorf("incoming prefix %s is configured with both weight %d and %d", prefix, existing, p.Weight)
+			}
+			weightForPrefix[prefix] = p.Weight
+		}
+	}
+	return nil
+}
+
+// validateIncomingModifiersAllowed checks that each prefix selector associated to
+// a modifier is covered by one of the allowed selectors, as the modifiers
+// are applied only to the prefixes that are allowed to be received.
+func validateIncomingModifiersAllowed(toReceive v1beta1.Receive, allowed []frr.IncomingFilter) error {
+	selectors := []v1beta1.PrefixSelector{}
+	for _, s := range toReceive.PrefixesWithLocalPref {
+		selectors = append(selectors, s.Prefixes...)
+	}
+	for _, s := range toReceive.PrefixesWithCommunity {
+		selectors = append(selectors, s.Prefixes...)
+	}
+	for _, s := range toReceive.PrefixesWithWeight {
+		selectors = append(selectors, s.Prefixes...)
+	}
+	for _, s := range selectors {
+		filter, err := filterForSelector(s)
+		if err != nil {
+			return err
+		}
+		covered := slices.ContainsFunc(allowed, func(a frr.IncomingFilter) bool {
+			return selectorCovers(a, filter)
+		})
+		if !covered {
+			return fmt.Errorf("prefix %s%s is not covered by the allowed prefixes", filter.Prefix, filter.Matcher())
+		}
+	}
+	return nil
+}
+
+// selectorCovers tells if all the prefixes matched by the inner selector
+// are matched by the outer one too.
+func selectorCovers(outer, inner frr.IncomingFilter) bool {
+	_, outerCIDR, err := net.ParseCIDR(outer.Prefix)
+	if err != nil {
+		return false
+	}
+	_, innerCIDR, err := net.ParseCIDR(inner.Prefix)
+	if err != nil {
+		return false
+	}
+	outerMask, outerBits := outerCIDR.Mask.Size()
+	innerMask, innerBits := innerCIDR.Mask.Size()
+	if outerBits != innerBits || outerMask > innerMask || !outerCIDR.Contains(innerCIDR.IP) {
+		return false
+	}
+	outerMin, outerMax := selectorLengths(outer, outerMask, outerBits)
+	innerMin, innerMax := selectorLengths(inner, innerMask, innerBits)
+	return outerMin <= innerMin && innerMax <= outerMax
+}
+
+// selectorLengths returns the range of prefix lengths matched by the
+// given selector.
+func selectorLengths(f frr.IncomingFilter, mask, bits int) (int, int) {
+	switch {
+	case f.GE == 0 && f.LE == 0:
+		return mask, mask
+	case f.GE == 0:
+		return mask, int(f.LE)
+	case f.LE == 0:
+		return int(f.GE), bits
+	}
+	return int(f.GE), int(f.LE)
 }
 
 func filterForSelector(selector v1beta1.PrefixSelector) (frr.IncomingFilter, error) {
@@ -554,7 +1443,15 @@ func validateSelectorLengths(mask, le, ge uint32) error {
 }
 
 func validateImportVRFs(r v1beta1.Router, allVRFs map[string][]string) error {
+	imported := sets.New[string]()
 	for _, i := range r.Imports {
+		if imported.Has(i.VRF) {
+			return fmt.Errorf("router %d-%s imports vrf %s multiple times", r.ASN, r.VRF, i.VRF)
+		}
+		imported.Insert(i.VRF)
+		if _, err := importFilters(i); err != nil {
+			return fmt.Errorf("router %d-%s has invalid import for vrf %s: %w", r.ASN, r.VRF, i.VRF, err)
+		}
 		if i.VRF == "default" {
 			continue
 		}
@@ -565,6 +1462,65 @@ func validateImportVRFs(r v1beta1.Router, allVRFs map[string][]string) error {
 	return nil
 }
 
+// importFilters returns, per ip family, the filters to apply to the routes
+// imported from a vrf. A family missing from the result is not imported,
+// while a family with no filters is imported entirely.
+func importFilters(i v1beta1.Import) (map[ipfamily.Family][]frr.IncomingFilter, error) {
+	families := []ipfamily.Family{ipfamily.IPv4, ipfamily.IPv6}
+	if len(i.Families) > 0 {
+		families = []ipfamily.Family{}
+		for _, f := range i.Families {
+			if f != v1beta1.ImportFamilyIPv4 && f != v1beta1.ImportFamilyIPv6 {
+				return nil, fmt.Errorf("invalid family %s, must be one of %s,%s", f, v1beta1.ImportFamilyIPv4, v1beta1.ImportFamilyIPv6)
+			}
+			families = append(families, ipfamily.Family(f))
+		}
+	}
+
+	filters := map[ipfamily.Family][]frr.IncomingFilter{}
+	for _, p := range i.Prefixes {
+		filter, err := filterForSelector(p)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(families, filter.IPFamily) {
+			return nil, fmt.Errorf("prefix %s specified for a family not imported", p.Prefix)
+		}
+		filters[filter.IPFamily] = append(filters[filter.IPFamily], filter)
+	}
+
+	res := map[ipfamily.Family][]frr.IncomingFilter{}
+	for _, f := range families {
+		// when filtering, a family with no selectors has nothing to import
+		if len(i.Prefixes) > 0 && len(filters[f]) == 0 {
+			continue
+		}
+		sort.Slice(filters[f], func(a, b int) bool {
+			return filters[f][a].LessThan(filters[f][b])
+		})
+		res[f] = filters[f]
+	}
+	return res, nil
+}
+
+func importsToFRR(imports []v1beta1.Import) ([]frr.ImportConfig, []frr.ImportConfig, error) {
+	v4 := make([]frr.ImportConfig, 0)
+	v6 := make([]frr.ImportConfig, 0)
+	for _, i := range imports {
+		filters, err := importFilters(i)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid import for vrf %s: %w", i.VRF, err)
+		}
+		if f, ok := filters[ipfamily.IPv4]; ok {
+			v4 = append(v4, frr.ImportConfig{VRF: i.VRF, Prefixes: f})
+		}
+		if f, ok := filters[ipfamily.IPv6]; ok {
+			v6 = append(v6, frr.ImportConfig{VRF: i.VRF, Prefixes: f})
+		}
+	}
+	return v4, v6, nil
+}
+
 func validateOutgoingPrefixes(prefixesInRouter []string, routerConfig v1beta1.Router) error {
 	prefixesSet := sets.New(prefixesInRouter...)
 	for _, n := range routerConfig.Neighbors {
@@ -593,7 +1549,70 @@ func validateOutgoingPrefixes(prefixesInRouter []string, routerConfig v1beta1.Ro
 			}
 		}
 
-		if n.ToAdvertise.Allowed.Mode == v1beta1.AllowAll {
+		asPathPrependForPrefix := map[string]string{}
+		for _, prefixes := range n.ToAdvertise.PrefixesWithASPathPrepend {
+			prepend := fmt.Sprintf("%d x%d", prefixes.ASN, asPathPrependRepeat(prefixes.Repeat))
+			if err := validatePrefixesForNeighborFamily(prefixes.Prefixes, neighborFamily); err != nil {
+				return fmt.Errorf("invalid prefixes %s for as path prepend %s for neighbor %s, err: %w", prefixes.Prefixes, prepend, neighborName(n), err)
+			}
+
+			for _, p := range prefixes.Prefixes { // check for multiple prepends on the same prefix
+				if existing, ok := asPathPrependForPrefix[p]; ok && existing != prepend {
+					return fmt.Errorf("prefix %s is configured with both as path prepend %s and %s", p, existing, prepend)
+				}
+				asPathPrependForPrefix[p] = prepend
+			}
+		}
+
+		medForPrefix := map[string]uint32{}
+		for _, prefixes := range n.ToAdvertise.PrefixesWithMED {
+			if err := validatePrefixesForNeighborFamily(prefixes.Prefixes, neighborFamily); err != nil {
+				return fmt.Errorf("invalid prefixes %s for med %d for neighbor %s, err: %w", prefixes.Prefixes, prefixes.MED, neighborName(n), err)
+			}
+
+			for _, p := range prefixes.Prefixes { // check for multiple meds on the same prefix
+				if existing, ok := medForPrefix[p]; ok && existing != prefixes.MED {
+					return fmt.Errorf("prefix %s is configured with both med %d and %d", p, existing, prefixes.MED)
+				}
+				medForPrefix[p] = prefixes.MED
+			}
+		}
+
+		originForPrefix := map[string]v1beta1.BGPOrigin{}
+		for _, prefixes := range n.ToAdvertise.PrefixesWithOrigin {
+			if err := validatePrefixesForNeighborFamily(prefixes.Prefixes, neighborFamily); err != nil {
+				return fmt.Errorf("invalid prefixes %s for origin %s for neighbor %s, err: %w", prefixes.Prefixes, prefixes.Origin, neighborName(n), err)
+			}
+
+			for _, p := range prefixes.Prefixes { // check for multiple origins on the same prefix
+				if existing, ok := originForPrefix[p]; ok && existing != prefixes.Origin {
+					return fmt.Errorf("prefix %s is configured with both origin %s and %s", p, existing, prefixes.Origin)
+				}
+				originForPrefix[p] = prefixes.Origin
+			}
+		}
+
+		linkBandwidthForPrefix := map[string]string{}
+		for _, prefixes := range n.ToAdvertise.PrefixesWithLinkBandwidth {
+			bandwidth, err := linkBandwidthFor(prefixes)
+			if err != nil {
+				return fmt.Errorf("invalid link bandwidth for neighbor %s, err: %w", neighborName(n), err)
+			}
+			if err := validatePrefixesForNeighborFamily(prefixes.Prefixes, neighborFamily); err != nil {
+				return fmt.Errorf("invalid prefixes %s for link bandwidth %s for neighbor %s, err: %w", prefixes.Prefixes, bandwidth, neighborName(n), err)
+			}
+
+			for _, p := range prefixes.Prefixes { // check for multiple link bandwidths on the same prefix
+				if existing, ok := linkBandwidthForPrefix[p]; ok && existing != bandwidth.String() {
+					return fmt.Errorf("prefix %s is configured with both link bandwidth %s and %s", p, existing, bandwidth)
+				}
+				linkBandwidthForPrefix[p] = bandwidth.String()
+			}
+		}
+
+		// redistributed routes are dynamic, the router prefixes are the
+		// only ones that can be validated.
+		if n.ToAdvertise.Allowed.Mode == v1beta1.AllowAll || n.ToAdvertise.Allowed.Mode == v1beta1.AllowRedistributed {
 			continue
 		}
 
@@ -601,6 +1620,9 @@ func validateOutgoingPrefixes(prefixesInRouter []string, routerConfig v1beta1.Ro
 			return fmt.Errorf("invalid allowed prefixes %s for neighbor %s, err: %w", n.ToAdvertise.Allowed.Prefixes, neighborName(n), err)
 		}
 		for _, p := range n.ToAdvertise.Allowed.Prefixes {
+			if isOriginatedDefaultRoute(p, n.ToAdvertise.DefaultOriginate) {
+				continue
+			}
 			if !prefixesSet.Has(p) {
 				return fmt.Errorf("trying to advertise non configured prefix %s to neighbor %s, vrf %s", p, neighborName(n), routerConfig.VRF)
 			}
@@ -609,6 +1631,89 @@ func validateOutgoingPrefixes(prefixesInRouter []string, routerConfig v1beta1.Ro
 	return nil
 }
 
+// isOriginatedDefaultRoute tells if the given prefix is a default route
+// originated towards the neighbor, and thus doesn't need to be configured
+// in the router.
+func isOriginatedDefaultRoute(prefix string, defaultOriginate v1beta1.DefaultOriginate) bool {
+	_, cidr, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false
+	}
+	if ones, _ := cidr.Mask.Size(); ones != 0 {
+		return false
+	}
+	if ipfamily.ForCIDR(cidr) == ipfamily.IPv4 {
+		return defaultOriginate.IPv4 != nil
+	}
+	return defaultOriginate.IPv6 != nil
+}
+
+type sessionType string
+
+const (
+	iBGPSession          sessionType = "iBGP"
+	eBGPSession          sessionType = "eBGP"
+	confederationSession sessionType = "confederation"
+)
+
+// sessionTypeFor tells the kind of the session with a neighbor having the
+// given asn, as rendered in the frr configuration. Sessions with the members
+// of the confederation of the router are neither plain iBGP nor plain eBGP ones.
+func sessionTypeFor(routerASN uint32, confederationPeers []uint32, neighborASN string) sessionType {
+	switch neighborASN {
+	case string(v1beta1.InternalASNMode):
+		return iBGPSession
+	case string(v1beta1.ExternalASNMode):
+		return eBGPSession
+	}
+	if strconv.FormatUint(uint64(routerASN), 10) == neighborASN {
+		return iBGPSession
+	}
+	for _, p := range confederationPeers {
+		if strconv.FormatUint(uint64(p), 10) == neighborASN {
+			return confederationSession
+		}
+	}
+	return eBGPSession
+}
+
+// validateSessionTypes checks that the neighbors of the router are configured
+// only with the settings supported by the type of their session.
+func validateSessionTypes(r *frr.RouterConfig) error {
+	for _, n := range r.Neighbors {
+		session := sessionTypeFor(r.MyASN, r.ConfederationPeers(), n.ASN)
+		if n.LocalASN != 0 && session != eBGPSession {
+			return fmt.Errorf("neighbor %s: localASN is not supported for %s sessions", n.Name, session)
+		}
+		if n.RouteReflectorClient && session != iBGPSession {
+			return fmt.Errorf("neighbor %s: routeReflectorClient is not supported for %s sessions", n.Name, session)
+		}
+		if n.ASOverride && session != eBGPSession {
+			return fmt.Errorf("neighbor %s: asOverride is not supported for %s sessions", n.Name, session)
+		}
+		if n.RemovePrivateAS != nil && session != eBGPSession {
+			return fmt.Errorf("neighbor %s: removePrivateAS is not supported for %s sessions", n.Name, session)
+		}
+	}
+	return nil
+}
+
+// validateClusterID checks that the given cluster id is either an
+// IPv4 address or a non zero 32 bits number, as frr expects.
+func validateClusterID(clusterID string) error {
+	if clusterID == "" {
+		return nil
+	}
+	if ip := net.ParseIP(clusterID); ip != nil && ip.To4() != nil {
+		return nil
+	}
+	id, err := strconv.ParseUint(clusterID, 10, 32)
+	if err != nil || id == 0 {
+		return fmt.Errorf("%s is neither an ipv4 address nor a number between 1 and 4294967295", clusterID)
+	}
+	return nil
+}
+
 func asnFor(n v1beta1.Neighbor) string {
 	asn := strconv.FormatUint(uint64(n.ASN), 10)
 	if n.DynamicASN != "" {
@@ -758,17 +1863,356 @@ func importedPrefixes(r v1beta1.Router, prefixesInRouter map[string][]string) ([
 		if !ok {
 			return nil, fmt.Errorf("vrf %s not found in prefixes in router", vrf)
 		}
-		res = append(res, imported...)
+		filters, err := importFilters(i)
+		if err != nil {
+			return nil, err
+		}
+		// only the leaked subset can be advertised
+		for _, p := range imported {
+			familyFilters, ok := filters[ipfamily.ForCIDRString(p)]
+			if !ok {
+				continue
+			}
+			if len(familyFilters) > 0 && !slices.ContainsFunc(familyFilters, func(f frr.IncomingFilter) bool {
+				return prefixMatchesFilter(p, f)
+			}) {
+				continue
+			}
+			res = append(res, p)
+		}
 	}
 	return res, nil
 }
 
+// prefixMatchesFilter tells if the given prefix is matched by the filter,
+// following the semantic of the frr prefix lists.
+func prefixMatchesFilter(prefix string, f frr.IncomingFilter) bool {
+	_, cidr, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return false
+	}
+	_, filterCIDR, err := net.ParseCIDR(f.Prefix)
+	if err != nil {
+		return false
+	}
+	length, bits := cidr.Mask.Size()
+	filterLength, filterBits := filterCIDR.Mask.Size()
+	if bits != filterBits || length < filterLength || !filterCIDR.Contains(cidr.IP) {
+		return false
+	}
+	if f.LE == 0 && f.GE == 0 {
+		return length == filterLength
+	}
+	minLength, maxLength := filterLength, bits
+	if f.GE != 0 {
+		minLength = int(f.GE)
+	}
+	if f.LE != 0 {
+		maxLength = int(f.LE)
+	}
+	return length >= minLength && length <= maxLength
+}
+
 func validateRouterConfig(r *frr.RouterConfig) error {
 	// merging with itself to validate neighbor list
 	_, err := mergeRouterConfigs(r, r)
 	return err
 }
 
+func bestPathToFRR(b *v1beta1.BestPath) *frr.BestPathConfig {
+	if b == nil {
+		return nil
+	}
+
+	res := &frr.BestPathConfig{
+		ASPathMultipathRelax: b.ASPathMultipathRelax,
+		DeterministicMED:     b.DeterministicMED,
+		CompareRouterID:      b.CompareRouterID,
+		AlwaysCompareMED:     b.AlwaysCompareMED,
+	}
+	if b.MaximumPaths != nil {
+		res.MaximumPathsEBGP = b.MaximumPaths.EBGP
+		res.MaximumPathsIBGP = b.MaximumPaths.IBGP
+	}
+	return res
+}
+
+func gracefulRestartToFRR(g *v1beta1.GracefulRestart) *frr.GracefulRestartConfig {
+	if g == nil {
+		return nil
+	}
+
+	seconds := func(d *v1.Duration) *int64 {
+		if d == nil {
+			return nil
+		}
+		return ptr.To(int64(d.Duration / time.Second))
+	}
+	return &frr.GracefulRestartConfig{
+		RestartTime:        seconds(g.RestartTime),
+		StalePathTime:      seconds(g.StalePathTime),
+		SelectDeferTime:    seconds(g.SelectDeferTime),
+		LongLivedStaleTime: seconds(g.LongLivedStaleTime),
+	}
+}
+
+func confederationToFRR(c *v1beta1.Confederation, routerASN uint32) (*frr.ConfederationConfig, error) {
+	if c == nil {
+		return nil, nil
+	}
+	if c.Identifier == 0 {
+		return nil, fmt.Errorf("identifier must be greater than 0")
+	}
+
+	peers := sets.New[uint32]()
+	for _, p := range c.Peers {
+		if p == routerASN {
+			return nil, fmt.Errorf("peer %d is the asn of the router", p)
+		}
+		if peers.Has(p) {
+			return nil, fmt.Errorf("duplicate peer %d", p)
+		}
+		peers.Insert(p)
+	}
+
+	return &frr.ConfederationConfig{
+		Identifier: c.Identifier,
+		Peers:      sets.List(peers),
+	}, nil
+}
+
+func gracefulRestartModeToFRR(n v1beta1.Neighbor) (string, error) {
+	switch n.GracefulRestartMode {
+	case "":
+		return "", nil
+	case v1beta1.GracefulRestartHelper, v1beta1.GracefulRestartDisabled:
+	default:
+		return "", fmt.Errorf("invalid graceful restart mode %s, must be one of %s,%s", n.GracefulRestartMode, v1beta1.GracefulRestartHelper, v1beta1.GracefulRestartDisabled)
+	}
+	if n.EnableGracefulRestart {
+		return "", fmt.Errorf("enableGracefulRestart and gracefulRestartMode are mutually exclusive")
+	}
+	if n.GracefulRestartMode == v1beta1.GracefulRestartDisabled {
+		return "disable", nil
+	}
+	return string(n.GracefulRestartMode), nil
+}
+
+func startupProtectionToFRR(s *v1beta1.StartupProtection, vrf string) (*frr.StartupProtectionConfig, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	if s.HoldUntilReady != nil && s.HoldUntilReady.ConditionType == "" && s.HoldUntilReady.Annotation == "" {
+		return nil, fmt.Errorf("holdUntilReady requires a condition type or an annotation")
+	}
+
+	if s.UpdateDelay == nil && s.MaxMedOnStartup == nil {
+		return nil, nil
+	}
+
+	res := &frr.StartupProtectionConfig{}
+	if s.UpdateDelay != nil {
+		if vrf != "" {
+			return nil, fmt.Errorf("updateDelay applies to all the bgp instances and can be set only on the router of the default vrf")
+		}
+		res.UpdateDelay = ptr.To(int64(s.UpdateDelay.Duration / time.Second))
+	}
+	if s.MaxMedOnStartup != nil {
+		res.MaxMedOnStartupPeriod = ptr.To(int64(s.MaxMedOnStartup.Period.Duration / time.Second))
+		res.MaxMedOnStartupValue = s.MaxMedOnStartup.Value
+	}
+	return res, nil
+}
+
+// heldVRFs returns the vrfs of the routers holding their advertisements
+// until the node is ready, when the node is not ready yet.
+func heldVRFs(resources ClusterResources) []string {
+	if resources.Node == nil {
+		return nil
+	}
+	held := sets.New[string]()
+	for _, cfg := range resources.FRRConfigs {
+		for _, r := range cfg.Spec.BGP.Routers {
+			if r.StartupProtection == nil || r.StartupProtection.HoldUntilReady == nil {
+				continue
+			}
+			if !nodeReady(resources.Node, r.StartupProtection.HoldUntilReady) {
+				held.Insert(r.VRF)
+			}
+		}
+	}
+	return sets.List(held)
+}
+
+// hasHoldUntilReady tells if any of the routers holds its advertisements
+// until the node is ready.
+func hasHoldUntilReady(cfgs []v1beta1.FRRConfiguration) bool {
+	return len(holdUntilReadyFor(cfgs)) > 0
+}
+
+// holdUntilReadyFor returns the conditions the routers holding their
+// advertisements until the node is ready are waiting for.
+func holdUntilReadyFor(cfgs []v1beta1.FRRConfiguration) []v1beta1.HoldUntilReady {
+	res := []v1beta1.HoldUntilReady{}
+	for _, cfg := range cfgs {
+		for _, r := range cfg.Spec.BGP.Routers {
+			if r.StartupProtection != nil && r.StartupProtection.HoldUntilReady != nil {
+				res = append(res, *r.StartupProtection.HoldUntilReady)
+			}
+		}
+	}
+	return res
+}
+
+func nodeReady(node *corev1.Node, hold *v1beta1.HoldUntilReady) bool {
+	if hold.Annotation != "" && node.Annotations[hold.Annotation] == "true" {
+		return true
+	}
+	if hold.ConditionType == "" {
+		return false
+	}
+	for _, c := range node.Status.Conditions {
+		if string(c.Type) == hold.ConditionType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// holdAdvertisements stops the router from advertising any route to its neighbors.
+func holdAdvertisements(r *frr.RouterConfig) {
+	if r == nil {
+		return
+	}
+	for _, n := range r.Neighbors {
+		n.Outgoing = frr.AllowedOut{}
+	}
+}
+
+func aggregatesToFRR(aggregates []v1beta1.Aggregate) ([]frr.AggregateConfig, []frr.AggregateConfig, error) {
+	v4 := make([]frr.AggregateConfig, 0)
+	v6 := make([]frr.AggregateConfig, 0)
+	seen := sets.New[string]()
+	for _, a := range aggregates {
+		_, cidr, err := net.ParseCIDR(a.Prefix)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid aggregate prefix %s, err: %w", a.Prefix, err)
+		}
+		prefix := cidr.String()
+		if seen.Has(prefix) {
+			return nil, nil, fmt.Errorf("duplicate aggregate prefix %s", prefix)
+		}
+		seen.Insert(prefix)
+
+		aggregate := frr.AggregateConfig{
+			Prefix:      prefix,
+			SummaryOnly: a.SummaryOnly,
+			ASSet:       a.ASSet,
+		}
+		if ipfamily.ForCIDR(cidr) == ipfamily.IPv4 {
+			v4 = append(v4, aggregate)
+			continue
+		}
+		v6 = append(v6, aggregate)
+	}
+	return v4, v6, nil
+}
+
+func staticRouteToFRR(r v1beta1.StaticRoute, bfdProfiles map[string]*frr.BFDProfile) (frr.StaticRouteConfig, error) {
+	_, cidr, err := net.ParseCIDR(r.Prefix)
+	if err != nil {
+		return frr.StaticRouteConfig{}, fmt.Errorf("invalid prefix, err: %w", err)
+	}
+	family := ipfamily.ForCIDR(cidr)
+
+	if r.NextHop == "" && r.Interface == "" {
+		return frr.StaticRouteConfig{}, fmt.Errorf("no next hop and no interface specified")
+	}
+	if r.NextHop != "" {
+		ip := net.ParseIP(r.NextHop)
+		if ip == nil {
+			return frr.StaticRouteConfig{}, fmt.Errorf("invalid next hop %s", r.NextHop)
+		}
+		if ipfamily.ForAddress(ip) != family {
+			return frr.StaticRouteConfig{}, fmt.Errorf("next hop %s is not %s", r.NextHop, family)
+		}
+	}
+	if r.BFDProfile != "" {
+		if r.NextHop == "" {
+			return frr.StaticRouteConfig{}, fmt.Errorf("bfd profile %s requires a next hop", r.BFDProfile)
+		}
+		if _, ok := bfdProfiles[r.BFDProfile]; !ok {
+			return frr.StaticRouteConfig{}, fmt.Errorf("referencing non existing BFDProfile %s", r.BFDProfile)
+		}
+	}
+
+	return frr.StaticRouteConfig{
+		IPFamily:   family,
+		Prefix:     cidr.String(),
+		NextHop:    r.NextHop,
+		Interface:  r.Interface,
+		Distance:   r.Distance,
+		Tag:        r.Tag,
+		VRF:        r.VRF,
+		NextHopVRF: r.NextHopVRF,
+		BFDProfile: r.BFDProfile,
+	}, nil
+}
+
+func redistributeToFRR(redistribute []v1beta1.Redistribute, vrf string) ([]frr.RedistributeConfig, error) {
+	res := map[string]frr.RedistributeConfig{}
+	for _, r := range redistribute {
+		cfg := frr.RedistributeConfig{
+			Source:     string(r.Source),
+			Metric:     r.Metric,
+			PrefixesV4: make([]frr.IncomingFilter, 0),
+			PrefixesV6: make([]frr.IncomingFilter, 0),
+			VRF:        vrf,
+		}
+		switch r.Source {
+		case v1beta1.RedistributeConnected, v1beta1.RedistributeStatic, v1beta1.RedistributeKernel:
+			if r.Table != nil {
+				return nil, fmt.Errorf("table set for source %s, only supported for source %s", r.Source, v1beta1.RedistributeTable)
+			}
+		case v1beta1.RedistributeTable:
+			if r.Table == nil {
+				return nil, fmt.Errorf("source %s requires a table", r.Source)
+			}
+			cfg.Table = *r.Table
+		default:
+			return nil, fmt.Errorf("unknown redistribute source %q", r.Source)
+		}
+
+		for _, p := range r.Prefixes {
+			filter, err := filterForSelector(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid prefix selector for source %s: %w", cfg.Command(), err)
+			}
+			if filter.IPFamily == ipfamily.IPv4 {
+				cfg.PrefixesV4 = append(cfg.PrefixesV4, filter)
+				continue
+			}
+			if r.Source == v1beta1.RedistributeTable {
+				return nil, fmt.Errorf("ipv6 prefix %s set for source %s, only ipv4 routes are redistributed from a table", p.Prefix, cfg.Command())
+			}
+			cfg.PrefixesV6 = append(cfg.PrefixesV6, filter)
+		}
+		sort.Slice(cfg.PrefixesV4, func(i, j int) bool {
+			return cfg.PrefixesV4[i].LessThan(cfg.PrefixesV4[j])
+		})
+		sort.Slice(cfg.PrefixesV6, func(i, j int) bool {
+			return cfg.PrefixesV6[i].LessThan(cfg.PrefixesV6[j])
+		})
+
+		if _, ok := res[cfg.RouteMapName()]; ok {
+			return nil, fmt.Errorf("duplicate redistribute source %s", cfg.Command())
+		}
+		res[cfg.RouteMapName()] = cfg
+	}
+	return sortMap(res), nil
+}
+
 func evpnToFRR(e *v1beta1.EVPNConfig) *frr.EVPNConfig {
 	if e == nil {
 		return nil
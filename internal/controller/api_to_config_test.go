@@ -28,7 +28,9 @@ func TestConversion(t *testing.T) {
 		name        string
 		fromK8s     []v1beta1.FRRConfiguration
 		secrets     map[string]v1.Secret
+		templates   map[string]v1beta1.BGPPeerTemplate
 		alwaysBlock []net.IPNet
+		node        *v1.Node
 		expected    *frr.Config
 		err         error
 	}{
@@ -919,6 +921,320 @@ func TestConversion(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "Neighbor with ToAdvertise, with as path prepend, med and origin",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.22",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+												PrefixesWithASPathPrepend: []v1beta1.ASPathPrependPrefixes{
+													{
+														Prefixes: []string{"192.0.2.0/24", "2001:db8::/64"},
+														ASN:      65040,
+														Repeat:   3,
+													},
+													{
+														Prefixes: []string{"192.0.3.0/24"},
+														ASN:      65040,
+													},
+												},
+												PrefixesWithMED: []v1beta1.MEDPrefixes{
+													{
+														Prefixes: []string{"192.0.2.0/24", "192.0.3.0/24"},
+														MED:      100,
+													},
+												},
+												PrefixesWithOrigin: []v1beta1.OriginPrefixes{
+													{
+														Prefixes: []string{"2001:db8::/64"},
+														Origin:   v1beta1.OriginIncomplete,
+													},
+												},
+											},
+											DualStackAddressFamily: true,
+										},
+									},
+									Prefixes: []string{"192.0.2.0/24", "192.0.3.0/24", "2001:db8::/64"},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:    65040,
+						RouterID: "192.0.2.20",
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.DualStack,
+								Name:     "65041@192.0.2.22",
+								ASN:      "65041",
+								Addr:     "192.0.2.22",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"192.0.2.0/24", "192.0.3.0/24"},
+									PrefixesV6: []string{"2001:db8::/64"},
+									ASPathPrependPrefixesModifiers: []frr.ASPathPrependPrefixList{
+										asPathPrependPrefixListFor("192.0.2.22", 65040, 1, "ip", []string{"192.0.3.0/24"}),
+										asPathPrependPrefixListFor("192.0.2.22", 65040, 3, "ip", []string{"192.0.2.0/24"}),
+										asPathPrependPrefixListFor("192.0.2.22", 65040, 3, "ipv6", []string{"2001:db8::/64"}),
+									},
+									MEDPrefixesModifiers: []frr.MEDPrefixList{
+										medPrefixListFor("192.0.2.22", 100, "ip", []string{"192.0.2.0/24", "192.0.3.0/24"}),
+									},
+									OriginPrefixesModifiers: []frr.OriginPrefixList{
+										originPrefixListFor("192.0.2.22", "incomplete", "ipv6", []string{"2001:db8::/64"}),
+									},
+								},
+							},
+						},
+						IPV4Prefixes: []string{"192.0.2.0/24", "192.0.3.0/24"},
+						IPV6Prefixes: []string{"2001:db8::/64"},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with ToAdvertise, with link bandwidth",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.22",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+												PrefixesWithLinkBandwidth: []v1beta1.LinkBandwidthPrefixes{
+													{
+														Prefixes:  []string{"192.0.2.0/24", "2001:db8::/64"},
+														Bandwidth: ptr.To[uint32](100),
+													},
+													{
+														Prefixes:      []string{"192.0.3.0/24"},
+														NumMultipaths: true,
+													},
+												},
+											},
+											DualStackAddressFamily: true,
+										},
+									},
+									Prefixes: []string{"192.0.2.0/24", "192.0.3.0/24", "2001:db8::/64"},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:    65040,
+						RouterID: "192.0.2.20",
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.DualStack,
+								Name:     "65041@192.0.2.22",
+								ASN:      "65041",
+								Addr:     "192.0.2.22",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"192.0.2.0/24", "192.0.3.0/24"},
+									PrefixesV6: []string{"2001:db8::/64"},
+									LinkBandwidthPrefixesModifiers: []frr.LinkBandwidthPrefixList{
+										linkBandwidthPrefixListFor("192.0.2.22", ptr.To[uint32](100), "ip", []string{"192.0.2.0/24"}),
+										linkBandwidthPrefixListFor("192.0.2.22", ptr.To[uint32](100), "ipv6", []string{"2001:db8::/64"}),
+										linkBandwidthPrefixListFor("192.0.2.22", nil, "ip", []string{"192.0.3.0/24"}),
+									},
+								},
+							},
+						},
+						IPV4Prefixes: []string{"192.0.2.0/24", "192.0.3.0/24"},
+						IPV6Prefixes: []string{"2001:db8::/64"},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "One neighbor, link bandwidth with both bandwidth and number of multipaths",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.22",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+												PrefixesWithLinkBandwidth: []v1beta1.LinkBandwidthPrefixes{
+													{
+														Prefixes:      []string{"192.0.2.0/24"},
+														Bandwidth:     ptr.To[uint32](100),
+														NumMultipaths: true,
+													},
+												},
+											},
+										},
+									},
+									Prefixes: []string{"192.0.2.0/24"},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid link bandwidth for neighbor 65041@192.0.2.22, err: link bandwidth bandwidth and numMultipaths are mutually exclusive"),
+		},
+		{
+			name: "One neighbor, trying to set multiple link bandwidths for a prefix",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.22",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+												PrefixesWithLinkBandwidth: []v1beta1.LinkBandwidthPrefixes{
+													{
+														Prefixes:  []string{"192.0.2.0/24"},
+														Bandwidth: ptr.To[uint32](100),
+													},
+													{
+														Prefixes:      []string{"192.0.2.0/24"},
+														NumMultipaths: true,
+													},
+												},
+											},
+										},
+									},
+									Prefixes: []string{"192.0.2.0/24"},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("prefix 192.0.2.0/24 is configured with both link bandwidth 100 and num-multipaths"),
+		},
+		{
+			name: "One neighbor, trying to set multiple meds for a prefix",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.22",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+												PrefixesWithMED: []v1beta1.MEDPrefixes{
+													{
+														Prefixes: []string{"192.0.2.0/24"},
+														MED:      100,
+													},
+													{
+														Prefixes: []string{"192.0.2.0/24"},
+														MED:      200,
+													},
+												},
+											},
+										},
+									},
+									Prefixes: []string{"192.0.2.0/24"},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("prefix 192.0.2.0/24 is configured with both med 100 and 200"),
+		},
+		{
+			name: "One neighbor, trying to set origin on non existing prefix",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.22",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Prefixes: []string{"192.0.2.0/24"},
+												},
+												PrefixesWithOrigin: []v1beta1.OriginPrefixes{
+													{
+														Prefixes: []string{"192.0.3.0/24"},
+														Origin:   v1beta1.OriginEGP,
+													},
+												},
+											},
+										},
+									},
+									Prefixes: []string{"192.0.2.0/24", "192.0.3.0/24"},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("origin egp associated to non existing prefix 192.0.3.0/24"),
+		},
 		{
 			name: "One neighbor, invalid address",
 			fromK8s: []v1beta1.FRRConfiguration{
@@ -1273,7 +1589,7 @@ func TestConversion(t *testing.T) {
 			err: nil,
 		},
 		{
-			name: "Neighbor with ToReceive some ips only",
+			name: "Neighbor with ToReceive, with local pref, community and weight",
 			fromK8s: []v1beta1.FRRConfiguration{
 				{
 					Spec: v1beta1.FRRConfigurationSpec{
@@ -1288,8 +1604,2944 @@ func TestConversion(t *testing.T) {
 											Address: "192.0.2.21",
 											ToReceive: v1beta1.Receive{
 												Allowed: v1beta1.AllowedInPrefixes{
-													Prefixes: []v1beta1.PrefixSelector{
-														{Prefix: "192.0.2.0/24"},
+													Mode: v1beta1.AllowAll,
+												},
+												PrefixesWithLocalPref: []v1beta1.LocalPrefPrefixSelectors{
+													{
+														Prefixes: []v1beta1.PrefixSelector{
+															{Prefix: "192.0.2.0/24", LE: 32},
+															{Prefix: "2001:db8::/64"},
+														},
+														LocalPref: 200,
+													},
+												},
+												PrefixesWithCommunity: []v1beta1.CommunityPrefixSelectors{
+													{
+														Prefixes: []v1beta1.PrefixSelector{
+															{Prefix: "192.0.3.0/24"},
+														},
+														Community: "10:100",
+													},
+												},
+												PrefixesWithWeight: []v1beta1.WeightPrefixSelectors{
+													{
+														Prefixes: []v1beta1.PrefixSelector{
+															{Prefix: "192.0.2.0/24", LE: 32},
+															{Prefix: "192.0.4.0/24"},
+														},
+														Weight: 100,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									All: true,
+									LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{
+										incomingLocalPrefPrefixListFor("192.0.2.21", 200, "ip", []string{"192.0.2.0/24 le 32"}),
+										incomingLocalPrefPrefixListFor("192.0.2.21", 200, "ipv6", []string{"2001:db8::/64"}),
+									},
+									CommunityPrefixesModifiers: []frr.CommunityPrefixList{
+										incomingCommunityPrefixListFor("192.0.2.21", "10:100", "ip", []string{"192.0.3.0/24"}),
+									},
+									WeightPrefixesModifiers: []frr.WeightPrefixList{
+										incomingWeightPrefixListFor("192.0.2.21", 100, "ip", []string{"192.0.2.0/24 le 32", "192.0.4.0/24"}),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with ToReceive, trying to set multiple weights for a prefix",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+												PrefixesWithWeight: []v1beta1.WeightPrefixSelectors{
+													{
+														Prefixes: []v1beta1.PrefixSelector{{Prefix: "192.0.2.0/24"}},
+														Weight:   100,
+													},
+													{
+														Prefixes: []v1beta1.PrefixSelector{{Prefix: "192.0.2.0/24"}},
+														Weight:   200,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("incoming prefix 192.0.2.0/24 is configured with both weight 100 and 200"),
+		},
+		{
+			name: "Neighbor with ToReceive, invalid selector for local pref",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												PrefixesWithLocalPref: []v1beta1.LocalPrefPrefixSelectors{
+													{
+														Prefixes:  []v1beta1.PrefixSelector{{Prefix: "192.0.2.0/24", LE: 16}},
+														LocalPref: 200,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid selector"),
+		},
+		{
+			name: "Neighbor with ToReceive, weight for a selector covered by the allowed ones",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Prefixes: []v1beta1.PrefixSelector{
+														{Prefix: "192.0.2.0/24", LE: 28},
+													},
+												},
+												PrefixesWithWeight: []v1beta1.WeightPrefixSelectors{
+													{
+														Prefixes: []v1beta1.PrefixSelector{
+															{Prefix: "192.0.2.0/24"},
+															{Prefix: "192.0.2.64/26", GE: 27, LE: 28},
+														},
+														Weight: 100,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{
+										{IPFamily: "ipv4", Prefix: "192.0.2.0/24", LE: 28},
+									},
+									WeightPrefixesModifiers: []frr.WeightPrefixList{
+										incomingWeightPrefixListFor("192.0.2.21", 100, "ip", []string{"192.0.2.0/24", "192.0.2.64/26 le 28 ge 27"}),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with ToReceive, local pref for a prefix not allowed",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Prefixes: []v1beta1.PrefixSelector{
+														{Prefix: "192.0.2.0/24", LE: 28},
+														{Prefix: "2001:db8::/64"},
+													},
+												},
+												PrefixesWithLocalPref: []v1beta1.LocalPrefPrefixSelectors{
+													{
+														Prefixes:  []v1beta1.PrefixSelector{{Prefix: "192.0.3.0/24"}},
+														LocalPref: 200,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("prefix 192.0.3.0/24 is not covered by the allowed prefixes"),
+		},
+		{
+			name: "Neighbor with ToReceive, community for a prefix not allowed",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Prefixes: []v1beta1.PrefixSelector{
+														{Prefix: "192.0.2.0/24", LE: 28},
+														{Prefix: "2001:db8::/64"},
+													},
+												},
+												PrefixesWithCommunity: []v1beta1.CommunityPrefixSelectors{
+													{
+														Prefixes:  []v1beta1.PrefixSelector{{Prefix: "2001:db8::/48"}},
+														Community: "10:100",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("prefix 2001:db8::/48 is not covered by the allowed prefixes"),
+		},
+		{
+			name: "Neighbor with ToReceive, weight for a selector wider than the allowed one",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Prefixes: []v1beta1.PrefixSelector{
+														{Prefix: "192.0.2.0/24", LE: 28},
+														{Prefix: "2001:db8::/64"},
+													},
+												},
+												PrefixesWithWeight: []v1beta1.WeightPrefixSelectors{
+													{
+														Prefixes: []v1beta1.PrefixSelector{{Prefix: "192.0.2.0/24", LE: 32}},
+														Weight:   100,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("prefix 192.0.2.0/24 le 32 is not covered by the allowed prefixes"),
+		},
+		{
+			name: "Neighbor with ToReceive communities and mode all",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Mode:        v1beta1.AllowAll,
+													Communities: []string{"10:100"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("communities and as paths can't be allowed with mode all for received prefixes of neighbor 65041@192.0.2.21"),
+		},
+		{
+			name: "Neighbor with ToReceive as paths and mode all",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Mode:    v1beta1.AllowAll,
+													ASPaths: []string{"^65041_"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("communities and as paths can't be allowed with mode all for received prefixes of neighbor 65041@192.0.2.21"),
+		},
+		{
+			name: "Neighbor with ToReceive communities and as paths",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Prefixes: []v1beta1.PrefixSelector{
+														{Prefix: "192.0.2.0/24"},
+													},
+													Communities: []string{"10:200", "large:123:456:7890", "10:100", "10:200"},
+													ASPaths:     []string{"^65041_"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{
+										{IPFamily: "ipv4", Prefix: "192.0.2.0/24"},
+									},
+									Communities:      []string{"10:100", "10:200"},
+									LargeCommunities: []string{"123:456:7890"},
+									ASPaths:          []string{"^65041_"},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with ToReceive extended community",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Communities: []string{"rt:65000:100"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process allowed communities for neighbor 65041@192.0.2.21, err: extended community rt:65000:100 can't be used to filter the received routes"),
+		},
+		{
+			name: "Neighbor with ToAdvertise, with extended communities",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.22",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+												PrefixesWithCommunity: []v1beta1.CommunityPrefixes{
+													{
+														Prefixes:  []string{"192.0.2.0/24"},
+														Community: "rt:65000:100",
+													},
+													{
+														Prefixes:  []string{"192.0.2.0/24", "192.0.3.0/24"},
+														Community: "soo:65000:100",
+													},
+													{
+														Prefixes:  []string{"192.0.3.0/24"},
+														Community: "65000:100",
+													},
+												},
+											},
+										},
+									},
+									Prefixes: []string{"192.0.2.0/24", "192.0.3.0/24"},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:    65040,
+						RouterID: "192.0.2.20",
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.22",
+								ASN:      "65041",
+								Addr:     "192.0.2.22",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"192.0.2.0/24", "192.0.3.0/24"},
+									CommunityPrefixesModifiers: []frr.CommunityPrefixList{
+										communityPrefixListFor("192.0.2.22", "65000:100", "ip", []string{"192.0.3.0/24"}),
+										communityPrefixListFor("192.0.2.22", "rt:65000:100", "ip", []string{"192.0.2.0/24"}),
+										communityPrefixListFor("192.0.2.22", "soo:65000:100", "ip", []string{"192.0.2.0/24", "192.0.3.0/24"}),
+									},
+								},
+							},
+						},
+						IPV4Prefixes: []string{"192.0.2.0/24", "192.0.3.0/24"},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with ToReceive invalid community",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Communities: []string{"10:100000"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid community 10:100000"),
+		},
+		{
+			name: "Neighbor with ToReceive as path with a newline",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													ASPaths: []string{"^65041_\nexit"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New(`failed to process allowed as paths for neighbor 65041@192.0.2.21, err: invalid as path regular expression "^65041_\nexit"`),
+		},
+		{
+			name: "Neighbor with ToReceive empty as path",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													ASPaths: []string{" "},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process allowed as paths for neighbor 65041@192.0.2.21, err: empty as path regular expression"),
+		},
+		{
+			name: "Neighbor with max prefixes",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											MaxPrefixes: &v1beta1.MaxPrefixes{
+												IPv4:            ptr.To[uint32](1000),
+												IPv6:            ptr.To[uint32](500),
+												RestartInterval: &metav1.Duration{Duration: 10 * time.Minute},
+											},
+											DualStackAddressFamily: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.DualStack,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								MaxPrefixes: &frr.MaxPrefixes{
+									IPv4:            ptr.To[uint32](1000),
+									IPv6:            ptr.To[uint32](500),
+									RestartInterval: ptr.To[uint32](10),
+								},
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with max prefixes, both warning only and restart interval",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											MaxPrefixes: &v1beta1.MaxPrefixes{
+												IPv4:            ptr.To[uint32](1000),
+												WarningOnly:     true,
+												RestartInterval: &metav1.Duration{Duration: 10 * time.Minute},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("max prefixes for neighbor 65041@192.0.2.21 can't have both warningOnly and restartInterval set"),
+		},
+		{
+			name: "Neighbor with ipv6 max prefixes on an ipv4 session",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											MaxPrefixes: &v1beta1.MaxPrefixes{
+												IPv6: ptr.To[uint32](1000),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("ipv6 max prefixes set for neighbor 65041@192.0.2.21 without an ipv6 address family"),
+		},
+		{
+			name: "Neighbors referencing a peer template",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:      65041,
+											Address:  "192.0.2.21",
+											Template: "template1",
+										},
+										{
+											ASN:      65042,
+											Address:  "192.0.2.22",
+											Template: "template1",
+											Port:     ptr.To[uint16](1179),
+											HoldTime: &metav1.Duration{
+												Duration: 30 * time.Second,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			templates: map[string]v1beta1.BGPPeerTemplate{
+				"template1": {
+					ObjectMeta: metav1.ObjectMeta{Name: "template1"},
+					Spec: v1beta1.BGPPeerTemplateSpec{
+						Neighbor: v1beta1.Neighbor{
+							Port: ptr.To[uint16](179),
+							HoldTime: &metav1.Duration{
+								Duration: 90 * time.Second,
+							},
+							KeepaliveTime: &metav1.Duration{
+								Duration: 30 * time.Second,
+							},
+							EBGPMultiHop: true,
+							ToReceive: v1beta1.Receive{
+								Allowed: v1beta1.AllowedInPrefixes{
+									Mode: v1beta1.AllowAll,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:      ipfamily.IPv4,
+								Name:          "65041@192.0.2.21",
+								ASN:           "65041",
+								Addr:          "192.0.2.21",
+								HoldTime:      ptr.To[int64](90),
+								KeepaliveTime: ptr.To[int64](30),
+								EBGPMultiHop:  true,
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									All: true,
+								},
+							},
+							{
+								IPFamily:      ipfamily.IPv4,
+								Name:          "65042@192.0.2.22",
+								ASN:           "65042",
+								Addr:          "192.0.2.22",
+								Port:          ptr.To[uint16](1179),
+								HoldTime:      ptr.To[int64](30),
+								KeepaliveTime: ptr.To[int64](30),
+								EBGPMultiHop:  true,
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									All: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor referencing a non existing peer template",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:      65041,
+											Address:  "192.0.2.21",
+											Template: "missing",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets:   map[string]v1.Secret{},
+			templates: map[string]v1beta1.BGPPeerTemplate{},
+			err:       errors.New("neighbor 65041@192.0.2.21 referencing non existing template missing"),
+		},
+		{
+			name: "Neighbor referencing a peer template with an address",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:      65041,
+											Address:  "192.0.2.21",
+											Template: "template1",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			templates: map[string]v1beta1.BGPPeerTemplate{
+				"template1": {
+					ObjectMeta: metav1.ObjectMeta{Name: "template1"},
+					Spec: v1beta1.BGPPeerTemplateSpec{
+						Neighbor: v1beta1.Neighbor{
+							Address: "192.0.2.30",
+						},
+					},
+				},
+			},
+			err: errors.New("template template1 can't set address, interface or template"),
+		},
+		{
+			name: "Router with listen ranges",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:         65040,
+									ID:          "192.0.2.20",
+									ListenLimit: ptr.To[uint32](100),
+									ListenRanges: []v1beta1.ListenRange{
+										{
+											Prefix:     "192.0.2.128/25",
+											DynamicASN: v1beta1.ExternalASNMode,
+											Template:   "template1",
+										},
+										{
+											Prefix: "fc00:f853:ccd:e800::/64",
+											ASN:    65041,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			templates: map[string]v1beta1.BGPPeerTemplate{
+				"template1": {
+					ObjectMeta: metav1.ObjectMeta{Name: "template1"},
+					Spec: v1beta1.BGPPeerTemplateSpec{
+						Neighbor: v1beta1.Neighbor{
+							HoldTime: &metav1.Duration{
+								Duration: 90 * time.Second,
+							},
+							KeepaliveTime: &metav1.Duration{
+								Duration: 30 * time.Second,
+							},
+							ToReceive: v1beta1.Receive{
+								Allowed: v1beta1.AllowedInPrefixes{
+									Mode: v1beta1.AllowAll,
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						ListenLimit:  ptr.To[uint32](100),
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:      ipfamily.IPv4,
+								Name:          "external@192.0.2.128/25",
+								ASN:           "external",
+								Addr:          "listen-192.0.2.128-25",
+								ListenRange:   "192.0.2.128/25",
+								HoldTime:      ptr.To[int64](90),
+								KeepaliveTime: ptr.To[int64](30),
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									All: true,
+								},
+							},
+							{
+								IPFamily:    ipfamily.IPv6,
+								Name:        "65041@fc00:f853:ccd:e800::/64",
+								ASN:         "65041",
+								Addr:        "listen-fc00:f853:ccd:e800::-64",
+								ListenRange: "fc00:f853:ccd:e800::/64",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Router with an invalid listen range",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									ListenRanges: []v1beta1.ListenRange{
+										{
+											Prefix: "192.0.2.128",
+											ASN:    65041,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid listen range prefix 192.0.2.128"),
+		},
+		{
+			name: "Routers with different listen limits",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:         65040,
+									ListenLimit: ptr.To[uint32](100),
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:         65040,
+									ListenLimit: ptr.To[uint32](50),
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("different listen limits (100 != 50) specified for same vrf: "),
+		},
+		{
+			name: "Router with route reflector clients",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:       65040,
+									ID:        "192.0.2.20",
+									ClusterID: "192.0.2.100",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:                  65040,
+											Address:              "192.0.2.21",
+											RouteReflectorClient: true,
+										},
+										{
+											DynamicASN:           v1beta1.InternalASNMode,
+											Address:              "192.0.2.22",
+											RouteReflectorClient: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65040,
+											Address: "192.0.2.21",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						ClusterID:    "192.0.2.100",
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:             ipfamily.IPv4,
+								Name:                 "65040@192.0.2.21",
+								ASN:                  "65040",
+								Addr:                 "192.0.2.21",
+								RouteReflectorClient: true,
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+							{
+								IPFamily:             ipfamily.IPv4,
+								Name:                 "internal@192.0.2.22",
+								ASN:                  "internal",
+								Addr:                 "192.0.2.22",
+								RouteReflectorClient: true,
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Route reflector client on an eBGP session",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:                  65041,
+											Address:              "192.0.2.21",
+											RouteReflectorClient: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("router 65040-: neighbor 65041@192.0.2.21: routeReflectorClient is not supported for eBGP sessions"),
+		},
+		{
+			name: "Router with an invalid cluster id",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:       65040,
+									ClusterID: "2001:db8::1",
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid cluster id for router 65040-"),
+		},
+		{
+			name: "Routers with different cluster ids",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:       65040,
+									ClusterID: "1",
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:       65040,
+									ClusterID: "2",
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("different cluster ids (1 != 2) specified for same vrf: "),
+		},
+		{
+			name: "Router with best path settings",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									BestPath: &v1beta1.BestPath{
+										MaximumPaths: &v1beta1.MaximumPaths{
+											EBGP: ptr.To[uint32](8),
+											IBGP: ptr.To[uint32](4),
+										},
+										ASPathMultipathRelax: true,
+										CompareRouterID:      true,
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:    65040,
+						RouterID: "192.0.2.20",
+						BestPath: &frr.BestPathConfig{
+							MaximumPathsEBGP:     ptr.To[uint32](8),
+							MaximumPathsIBGP:     ptr.To[uint32](4),
+							ASPathMultipathRelax: true,
+							CompareRouterID:      true,
+						},
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Routers with conflicting best path settings",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									BestPath: &v1beta1.BestPath{
+										DeterministicMED: true,
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									BestPath: &v1beta1.BestPath{
+										AlwaysCompareMED: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("different best path settings specified for same vrf: "),
+		},
+		{
+			name: "Router with graceful restart settings",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									GracefulRestart: &v1beta1.GracefulRestart{
+										RestartTime:        &metav1.Duration{Duration: time.Minute},
+										StalePathTime:      &metav1.Duration{Duration: 2 * time.Minute},
+										SelectDeferTime:    &metav1.Duration{Duration: 30 * time.Second},
+										LongLivedStaleTime: &metav1.Duration{Duration: time.Hour},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:                   65041,
+											Address:               "192.0.2.21",
+											EnableGracefulRestart: true,
+										},
+										{
+											ASN:                 65042,
+											Address:             "192.0.2.22",
+											GracefulRestartMode: v1beta1.GracefulRestartHelper,
+										},
+										{
+											ASN:                 65043,
+											Address:             "192.0.2.23",
+											GracefulRestartMode: v1beta1.GracefulRestartDisabled,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN: 65040,
+						GracefulRestart: &frr.GracefulRestartConfig{
+							RestartTime:        ptr.To[int64](60),
+							StalePathTime:      ptr.To[int64](120),
+							SelectDeferTime:    ptr.To[int64](30),
+							LongLivedStaleTime: ptr.To[int64](3600),
+						},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:        ipfamily.IPv4,
+								Name:            "65041@192.0.2.21",
+								ASN:             "65041",
+								Addr:            "192.0.2.21",
+								GracefulRestart: true,
+							},
+							{
+								IPFamily:            ipfamily.IPv4,
+								Name:                "65042@192.0.2.22",
+								ASN:                 "65042",
+								Addr:                "192.0.2.22",
+								GracefulRestartMode: "helper",
+							},
+							{
+								IPFamily:            ipfamily.IPv4,
+								Name:                "65043@192.0.2.23",
+								ASN:                 "65043",
+								Addr:                "192.0.2.23",
+								GracefulRestartMode: "disable",
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with both graceful restart and a graceful restart mode",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:                   65041,
+											Address:               "192.0.2.21",
+											EnableGracefulRestart: true,
+											GracefulRestartMode:   v1beta1.GracefulRestartHelper,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process neighbor 65041@192.0.2.21 for router 65040-: neighbor 65041@192.0.2.21: enableGracefulRestart and gracefulRestartMode are mutually exclusive"),
+		},
+		{
+			name: "Neighbor with shutdown and description",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:             65041,
+											Address:         "192.0.2.21",
+											Description:     "tor A",
+											Shutdown:        true,
+											ShutdownMessage: "maintenance",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN: 65040,
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:        ipfamily.IPv4,
+								Name:            "65041@192.0.2.21",
+								ASN:             "65041",
+								Addr:            "192.0.2.21",
+								Description:     "tor A",
+								Shutdown:        true,
+								ShutdownMessage: "maintenance",
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with shutdown message but no shutdown",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:             65041,
+											Address:         "192.0.2.21",
+											ShutdownMessage: "maintenance",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process neighbor 65041@192.0.2.21 for router 65040-: neighbor 65041@192.0.2.21: shutdownMessage requires shutdown to be set"),
+		},
+		{
+			name: "Neighbor with a multi line description",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:         65041,
+											Address:     "192.0.2.21",
+											Description: "tor A\nneighbor 192.0.2.21 password foo",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process neighbor 65041@192.0.2.21 for router 65040-: neighbor 65041@192.0.2.21: description and shutdownMessage must be a single line"),
+		},
+		{
+			name: "Neighbors with as path loop settings",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:        65041,
+											Address:    "192.0.2.21",
+											AllowASIn:  &v1beta1.AllowASIn{},
+											ASOverride: true,
+											RemovePrivateAS: &v1beta1.RemovePrivateAS{
+												ReplaceAS: true,
+											},
+										},
+										{
+											ASN:     65040,
+											Address: "192.0.2.22",
+											AllowASIn: &v1beta1.AllowASIn{
+												Count: ptr.To[uint32](5),
+											},
+										},
+										{
+											ASN:     65040,
+											Address: "192.0.2.23",
+											AllowASIn: &v1beta1.AllowASIn{
+												Origin: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN: 65040,
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:   ipfamily.IPv4,
+								Name:       "65041@192.0.2.21",
+								ASN:        "65041",
+								Addr:       "192.0.2.21",
+								AllowASIn:  "3",
+								ASOverride: true,
+								RemovePrivateAS: &frr.RemovePrivateAS{
+									ReplaceAS: true,
+								},
+							},
+							{
+								IPFamily:  ipfamily.IPv4,
+								Name:      "65040@192.0.2.22",
+								ASN:       "65040",
+								Addr:      "192.0.2.22",
+								AllowASIn: "5",
+							},
+							{
+								IPFamily:  ipfamily.IPv4,
+								Name:      "65040@192.0.2.23",
+								ASN:       "65040",
+								Addr:      "192.0.2.23",
+								AllowASIn: "origin",
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with both allowas-in count and origin",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											AllowASIn: &v1beta1.AllowASIn{
+												Count:  ptr.To[uint32](2),
+												Origin: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process neighbor 65041@192.0.2.21 for router 65040-: neighbor 65041@192.0.2.21: allowASIn count and origin are mutually exclusive"),
+		},
+		{
+			name: "iBGP neighbor with as override",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:        65040,
+											Address:    "192.0.2.21",
+											ASOverride: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("router 65040-: neighbor 65040@192.0.2.21: asOverride is not supported for iBGP sessions"),
+		},
+		{
+			name: "iBGP neighbor with remove private AS",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											DynamicASN:      v1beta1.InternalASNMode,
+											Address:         "192.0.2.21",
+											RemovePrivateAS: &v1beta1.RemovePrivateAS{},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("router 65040-: neighbor internal@192.0.2.21: removePrivateAS is not supported for iBGP sessions"),
+		},
+		{
+			name: "Neighbors with ttl security and passive",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:             65041,
+											Address:         "192.0.2.21",
+											TTLSecurityHops: ptr.To[uint32](1),
+											Passive:         true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN: 65040,
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:        ipfamily.IPv4,
+								Name:            "65041@192.0.2.21",
+								ASN:             "65041",
+								Addr:            "192.0.2.21",
+								TTLSecurityHops: ptr.To[uint32](1),
+								Passive:         true,
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with both ttl security and ebgp multihop",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:             65041,
+											Address:         "192.0.2.21",
+											TTLSecurityHops: ptr.To[uint32](1),
+											EBGPMultiHop:    true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process neighbor 65041@192.0.2.21 for router 65040-: neighbor 65041@192.0.2.21: ttlSecurityHops and ebgpMultiHop are mutually exclusive"),
+		},
+		{
+			name: "Neighbor with extended next hop",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:                    65041,
+											Address:                "2001:db8::21",
+											DualStackAddressFamily: true,
+											ExtendedNextHop:        true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN: 65040,
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:        ipfamily.DualStack,
+								Name:            "65041@2001:db8::21",
+								ASN:             "65041",
+								Addr:            "2001:db8::21",
+								ExtendedNextHop: true,
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with extended next hop over an IPv4 session",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:                    65041,
+											Address:                "192.0.2.21",
+											DualStackAddressFamily: true,
+											ExtendedNextHop:        true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process neighbor 65041@192.0.2.21 for router 65040-: neighbor 65041@192.0.2.21: extendedNextHop requires an IPv6 session, got ipv4"),
+		},
+		{
+			name: "Neighbor with extended next hop without dual stack",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:             65041,
+											Address:         "2001:db8::21",
+											ExtendedNextHop: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process neighbor 65041@2001:db8::21 for router 65040-: neighbor 65041@2001:db8::21: extendedNextHop requires dualStackAddressFamily"),
+		},
+		{
+			name: "Router with startup protection",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									StartupProtection: &v1beta1.StartupProtection{
+										UpdateDelay: &metav1.Duration{Duration: 2 * time.Minute},
+										MaxMedOnStartup: &v1beta1.MaxMedOnStartup{
+											Period: metav1.Duration{Duration: 5 * time.Minute},
+											Value:  ptr.To[uint32](1000),
+										},
+									},
+								},
+								{
+									ASN: 65040,
+									VRF: "red",
+									StartupProtection: &v1beta1.StartupProtection{
+										MaxMedOnStartup: &v1beta1.MaxMedOnStartup{
+											Period: metav1.Duration{Duration: time.Minute},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN: 65040,
+						StartupProtection: &frr.StartupProtectionConfig{
+							UpdateDelay:           ptr.To[int64](120),
+							MaxMedOnStartupPeriod: ptr.To[int64](300),
+							MaxMedOnStartupValue:  ptr.To[uint32](1000),
+						},
+					},
+					{
+						MyASN: 65040,
+						VRF:   "red",
+						StartupProtection: &frr.StartupProtectionConfig{
+							MaxMedOnStartupPeriod: ptr.To[int64](60),
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Routers with conflicting startup protection",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									StartupProtection: &v1beta1.StartupProtection{
+										UpdateDelay: &metav1.Duration{Duration: time.Minute},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									StartupProtection: &v1beta1.StartupProtection{
+										UpdateDelay: &metav1.Duration{Duration: 2 * time.Minute},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("different startup protection settings specified for same vrf: "),
+		},
+		{
+			name: "Update delay on a vrf router",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									VRF: "red",
+									StartupProtection: &v1beta1.StartupProtection{
+										UpdateDelay: &metav1.Duration{Duration: 2 * time.Minute},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid startup protection for router 65040-red: updateDelay applies to all the bgp instances and can be set only on the router of the default vrf"),
+		},
+		{
+			name: "Hold until ready without condition nor annotation",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									StartupProtection: &v1beta1.StartupProtection{
+										HoldUntilReady: &v1beta1.HoldUntilReady{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid startup protection for router 65040-: holdUntilReady requires a condition type or an annotation"),
+		},
+		{
+			name: "Hold until ready, node not ready",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:      65040,
+									Prefixes: []string{"192.0.2.0/24"},
+									StartupProtection: &v1beta1.StartupProtection{
+										HoldUntilReady: &v1beta1.HoldUntilReady{
+											ConditionType: "NetworkReady",
+											Annotation:    "example.com/network-ready",
+										},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			node: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"example.com/network-ready": "false"},
+				},
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{Type: "NetworkReady", Status: v1.ConditionFalse},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						IPV4Prefixes: []string{"192.0.2.0/24"},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Hold until ready, node ready by condition",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:      65040,
+									Prefixes: []string{"192.0.2.0/24"},
+									StartupProtection: &v1beta1.StartupProtection{
+										HoldUntilReady: &v1beta1.HoldUntilReady{
+											ConditionType: "NetworkReady",
+											Annotation:    "example.com/network-ready",
+										},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{
+						{Type: "NetworkReady", Status: v1.ConditionTrue},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						IPV4Prefixes: []string{"192.0.2.0/24"},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"192.0.2.0/24"},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Hold until ready, node ready by annotation",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:      65040,
+									Prefixes: []string{"192.0.2.0/24"},
+									StartupProtection: &v1beta1.StartupProtection{
+										HoldUntilReady: &v1beta1.HoldUntilReady{
+											ConditionType: "NetworkReady",
+											Annotation:    "example.com/network-ready",
+										},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			node: &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"example.com/network-ready": "true"},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						IPV4Prefixes: []string{"192.0.2.0/24"},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"192.0.2.0/24"},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with add path",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65040,
+											Address: "192.0.2.21",
+											AddPath: &v1beta1.AddPath{
+												TX: v1beta1.AddPathTXBestPathPerAS,
+												RX: ptr.To(false),
+											},
+										},
+										{
+											ASN:     65040,
+											Address: "192.0.2.22",
+											AddPath: &v1beta1.AddPath{
+												TX: v1beta1.AddPathTXAll,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily:          ipfamily.IPv4,
+								Name:              "65040@192.0.2.21",
+								ASN:               "65040",
+								Addr:              "192.0.2.21",
+								AddPathTX:         "bestpath-per-as",
+								AddPathRXDisabled: true,
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+							{
+								IPFamily:  ipfamily.IPv4,
+								Name:      "65040@192.0.2.22",
+								ASN:       "65040",
+								Addr:      "192.0.2.22",
+								AddPathTX: "all",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with invalid add path tx",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65040,
+											Address: "192.0.2.21",
+											AddPath: &v1beta1.AddPath{
+												TX: "best",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("neighbor 65040@192.0.2.21 has invalid add path tx best specified, must be one of all,bestpath-per-as"),
+		},
+		{
+			name: "Router with redistribute",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:      65040,
+									ID:       "192.0.2.20",
+									VRF:      "red",
+									Prefixes: []string{"192.0.2.0/24"},
+									Redistribute: []v1beta1.Redistribute{
+										{
+											Source: v1beta1.RedistributeConnected,
+											Prefixes: []v1beta1.PrefixSelector{
+												{
+													Prefix: "2001:db8::/32",
+													LE:     64,
+												},
+												{
+													Prefix: "10.0.0.0/8",
+													GE:     24,
+												},
+											},
+											Metric: ptr.To[uint32](100),
+										},
+										{
+											Source: v1beta1.RedistributeTable,
+											Table:  ptr.To[uint32](10),
+										},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowRedistributed,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						VRF:          "red",
+						IPV4Prefixes: []string{"192.0.2.0/24"},
+						IPV6Prefixes: []string{},
+						Redistribute: []frr.RedistributeConfig{
+							{
+								Source: "connected",
+								Metric: ptr.To[uint32](100),
+								PrefixesV4: []frr.IncomingFilter{
+									{
+										IPFamily: ipfamily.IPv4,
+										Prefix:   "10.0.0.0/8",
+										GE:       24,
+									},
+								},
+								PrefixesV6: []frr.IncomingFilter{
+									{
+										IPFamily: ipfamily.IPv6,
+										Prefix:   "2001:db8::/32",
+										LE:       64,
+									},
+								},
+								VRF: "red",
+							},
+							{
+								Source: "table",
+								Table:  10,
+								VRF:    "red",
+							},
+						},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								VRFName:  "red",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4:    []string{"192.0.2.0/24"},
+									PrefixesV6:    []string{},
+									Redistributed: true,
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Router redistributing a table without id",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Redistribute: []v1beta1.Redistribute{
+										{
+											Source: v1beta1.RedistributeTable,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid redistribute for router 65040-: source table requires a table"),
+		},
+		{
+			name: "Neighbor receiving in redistributed mode",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Mode: v1beta1.AllowRedistributed,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("mode redistributed is not supported for received prefixes of neighbor 65041@192.0.2.21"),
+		},
+		{
+			name: "Router with aggregates",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:      65040,
+									ID:       "192.0.2.20",
+									Prefixes: []string{"192.0.2.1/32", "192.0.2.2/32"},
+									Aggregates: []v1beta1.Aggregate{
+										{
+											Prefix:      "192.0.2.0/24",
+											SummaryOnly: true,
+										},
+										{
+											Prefix: "2001:db8::/32",
+											ASSet:  true,
+										},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Prefixes: []string{"192.0.2.0/24"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{"192.0.2.1/32", "192.0.2.2/32"},
+						IPV6Prefixes: []string{},
+						IPV4Aggregates: []frr.AggregateConfig{
+							{
+								Prefix:      "192.0.2.0/24",
+								SummaryOnly: true,
+							},
+						},
+						IPV6Aggregates: []frr.AggregateConfig{
+							{
+								Prefix: "2001:db8::/32",
+								ASSet:  true,
+							},
+						},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"192.0.2.0/24"},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Router with aggregates with host bits set",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Aggregates: []v1beta1.Aggregate{
+										{
+											Prefix: "10.0.0.1/24",
+										},
+										{
+											Prefix: "2001:db8::1/32",
+										},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Prefixes: []string{"10.0.0.0/24"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{},
+						IPV6Prefixes: []string{},
+						IPV4Aggregates: []frr.AggregateConfig{
+							{
+								Prefix: "10.0.0.0/24",
+							},
+						},
+						IPV6Aggregates: []frr.AggregateConfig{
+							{
+								Prefix: "2001:db8::/32",
+							},
+						},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"10.0.0.0/24"},
+									PrefixesV6: []string{},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Router with the same aggregate with and without host bits",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Aggregates: []v1beta1.Aggregate{
+										{
+											Prefix: "10.0.0.0/24",
+										},
+										{
+											Prefix: "10.0.0.1/24",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid aggregates for router 65040-: duplicate aggregate prefix 10.0.0.0/24"),
+		},
+		{
+			name: "Router with invalid aggregate",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Aggregates: []v1beta1.Aggregate{
+										{
+											Prefix: "192.0.2.0",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid aggregate prefix 192.0.2.0 for router 65040-, err: invalid CIDR address: 192.0.2.0"),
+		},
+		{
+			name: "Neighbor with conditional advertisement",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:      65040,
+									ID:       "192.0.2.20",
+									Prefixes: []string{"192.0.3.0/24", "192.0.4.0/24"},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+												Conditional: []v1beta1.ConditionalAdvertisement{
+													{
+														Prefixes: []string{"192.0.4.0/24"},
+														Condition: v1beta1.AdvertisementCondition{
+															Type: v1beta1.AdvertisementConditionNonExist,
+															Selector: v1beta1.PrefixSelector{
+																Prefix: "192.0.3.0/24",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{"192.0.3.0/24", "192.0.4.0/24"},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"192.0.3.0/24", "192.0.4.0/24"},
+									PrefixesV6: []string{},
+									ConditionalV4: &frr.ConditionalAdvertisement{
+										Prefixes:  []string{"192.0.4.0/24"},
+										Condition: "non-exist",
+										ConditionPrefix: frr.IncomingFilter{
+											IPFamily: ipfamily.IPv4,
+											Prefix:   "192.0.3.0/24",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor with conditional advertisement of a non allowed prefix",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:      65040,
+									Prefixes: []string{"192.0.3.0/24", "192.0.4.0/24"},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Prefixes: []string{"192.0.3.0/24"},
+												},
+												Conditional: []v1beta1.ConditionalAdvertisement{
+													{
+														Prefixes: []string{"192.0.4.0/24"},
+														Condition: v1beta1.AdvertisementCondition{
+															Type: v1beta1.AdvertisementConditionExist,
+															Selector: v1beta1.PrefixSelector{
+																Prefix: "192.0.3.0/24",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("failed to process neighbor 65041@192.0.2.21 for router 65040-: failed to process conditional advertisements for neighbor 65041@192.0.2.21, err: conditional advertisement associated to non existing prefix 192.0.4.0/24"),
+		},
+		{
+			name: "Neighbor with default originate",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN:      65040,
+									ID:       "192.0.2.20",
+									Prefixes: []string{"192.0.2.0/24"},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Prefixes: []string{"0.0.0.0/0", "192.0.2.0/24"},
+												},
+												DefaultOriginate: v1beta1.DefaultOriginate{
+													IPv4: &v1beta1.DefaultRoute{
+														ConditionPrefix: "192.0.2.0/24",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:        65040,
+						RouterID:     "192.0.2.20",
+						IPV4Prefixes: []string{"192.0.2.0/24"},
+						IPV6Prefixes: []string{},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"0.0.0.0/0", "192.0.2.0/24"},
+									PrefixesV6: []string{},
+									DefaultOriginateV4: &frr.DefaultOriginate{
+										ConditionPrefix: "192.0.2.0/24",
+									},
+								},
+								Incoming: frr.AllowedIn{
+									PrefixesV4: []frr.IncomingFilter{},
+									PrefixesV6: []frr.IncomingFilter{},
+								},
+							},
+						},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Neighbor advertising the default route without default originate",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Prefixes: []string{"0.0.0.0/0"},
+												},
+												DefaultOriginate: v1beta1.DefaultOriginate{
+													IPv6: &v1beta1.DefaultRoute{},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("trying to advertise non configured prefix 0.0.0.0/0 to neighbor 65041@192.0.2.21, vrf "),
+		},
+		{
+			name: "Neighbor with default originate condition prefix of the wrong family",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												DefaultOriginate: v1beta1.DefaultOriginate{
+													IPv4: &v1beta1.DefaultRoute{
+														ConditionPrefix: "2001:db8::/64",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("ipv4 default originate condition prefix \"2001:db8::/64\" for neighbor 65041@192.0.2.21 is not ipv4"),
+		},
+		{
+			name: "Neighbor with ToReceive some ips only",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToReceive: v1beta1.Receive{
+												Allowed: v1beta1.AllowedInPrefixes{
+													Prefixes: []v1beta1.PrefixSelector{
+														{Prefix: "192.0.2.0/24"},
 														{Prefix: "192.0.3.0/24"},
 														{Prefix: "192.0.4.0/24"},
 														{Prefix: "2001:db8::/64"},
@@ -2214,6 +5466,174 @@ func TestConversion(t *testing.T) {
 			},
 			err: nil,
 		},
+		{
+			name: "Static routes from multiple configs",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							BFDProfiles: []v1beta1.BFDProfile{
+								{
+									Name: "bfd1",
+								},
+							},
+						},
+						Static: v1beta1.StaticConfig{
+							Routes: []v1beta1.StaticRoute{
+								{
+									Prefix:     "192.0.3.0/24",
+									NextHop:    "192.0.2.1",
+									Distance:   ptr.To[uint32](10),
+									BFDProfile: "bfd1",
+								},
+								{
+									Prefix:     "2001:db8::/64",
+									Interface:  "eth0",
+									Tag:        ptr.To[uint32](20),
+									VRF:        "red",
+									NextHopVRF: "default",
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							BFDProfiles: []v1beta1.BFDProfile{
+								{
+									Name: "bfd1",
+								},
+							},
+						},
+						Static: v1beta1.StaticConfig{
+							Routes: []v1beta1.StaticRoute{
+								{
+									Prefix:     "192.0.3.0/24",
+									NextHop:    "192.0.2.1",
+									Distance:   ptr.To[uint32](10),
+									BFDProfile: "bfd1",
+								},
+								{
+									Prefix:  "192.0.3.0/24",
+									NextHop: "192.0.2.2",
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				BFDProfiles: []frr.BFDProfile{
+					{
+						Name: "bfd1",
+					},
+				},
+				StaticRoutes: []frr.StaticRouteConfig{
+					{
+						IPFamily:   ipfamily.IPv6,
+						Prefix:     "2001:db8::/64",
+						Interface:  "eth0",
+						Tag:        ptr.To[uint32](20),
+						VRF:        "red",
+						NextHopVRF: "default",
+					},
+					{
+						IPFamily:   ipfamily.IPv4,
+						Prefix:     "192.0.3.0/24",
+						NextHop:    "192.0.2.1",
+						Distance:   ptr.To[uint32](10),
+						BFDProfile: "bfd1",
+					},
+					{
+						IPFamily: ipfamily.IPv4,
+						Prefix:   "192.0.3.0/24",
+						NextHop:  "192.0.2.2",
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Same static route with different settings",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						Static: v1beta1.StaticConfig{
+							Routes: []v1beta1.StaticRoute{
+								{
+									Prefix:   "192.0.3.0/24",
+									NextHop:  "192.0.2.1",
+									Distance: ptr.To[uint32](10),
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						Static: v1beta1.StaticConfig{
+							Routes: []v1beta1.StaticRoute{
+								{
+									Prefix:   "192.0.3.0/24",
+									NextHop:  "192.0.2.1",
+									Distance: ptr.To[uint32](20),
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("different settings specified for static route 192.0.3.0/24 via 192.0.2.1 in vrf \"\""),
+		},
+		{
+			name: "Static route with BFDProfile and no next hop",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							BFDProfiles: []v1beta1.BFDProfile{
+								{
+									Name: "bfd1",
+								},
+							},
+						},
+						Static: v1beta1.StaticConfig{
+							Routes: []v1beta1.StaticRoute{
+								{
+									Prefix:     "192.0.3.0/24",
+									Interface:  "eth0",
+									BFDProfile: "bfd1",
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid static route 192.0.3.0/24 in config : bfd profile bfd1 requires a next hop"),
+		},
+		{
+			name: "Static route with next hop of the wrong family",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						Static: v1beta1.StaticConfig{
+							Routes: []v1beta1.StaticRoute{
+								{
+									Prefix:  "2001:db8::/64",
+									NextHop: "192.0.2.1",
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid static route 2001:db8::/64 in config : next hop 192.0.2.1 is not ipv6"),
+		},
 		{
 			name: "Neighbor with BFDProfile does not exist",
 			fromK8s: []v1beta1.FRRConfiguration{
@@ -2531,76 +5951,237 @@ func TestConversion(t *testing.T) {
 						},
 					},
 					{
-						MyASN:    65013,
-						RouterID: "2001:db8::3",
-						Neighbors: []*frr.NeighborConfig{
+						MyASN:    65013,
+						RouterID: "2001:db8::3",
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv6,
+								Name:     "65014@2001:db8::4",
+								ASN:      "65014",
+								Addr:     "2001:db8::4",
+								VRFName:  "vrf2",
+								AlwaysBlock: []frr.IncomingFilter{
+									{
+										IPFamily: ipfamily.IPv4,
+										Prefix:   "192.168.1.0/24",
+										LE:       uint32(32),
+									}, {
+										IPFamily: ipfamily.IPv6,
+										Prefix:   "fc00:f853:ccd:e800::/64",
+										LE:       uint32(128),
+									},
+								},
+							},
+						},
+						VRF: "vrf2",
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Multiple Routers import VRFs",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65010,
+									ID:  "192.0.2.5",
+									VRF: "",
+									Imports: []v1beta1.Import{
+										{VRF: "red"},
+									},
+								},
+								{
+									ASN: 65013,
+									ID:  "2001:db8::3",
+									VRF: "red",
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:       65010,
+						RouterID:    "192.0.2.5",
+						VRF:         "",
+						IPV4Imports: []frr.ImportConfig{{VRF: "red"}},
+						IPV6Imports: []frr.ImportConfig{{VRF: "red"}},
+					},
+					{
+						MyASN:    65013,
+						RouterID: "2001:db8::3",
+						VRF:      "red",
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Multiple Routers filtered import VRF, advertise ips leaked from the imported vrf",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									ID:  "192.0.2.20",
+									Imports: []v1beta1.Import{
+										{
+											VRF:      "red",
+											Families: []v1beta1.ImportFamily{v1beta1.ImportFamilyIPv4},
+											Prefixes: []v1beta1.PrefixSelector{
+												{
+													Prefix: "192.0.4.0/22",
+													LE:     24,
+												},
+											},
+										},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Mode: v1beta1.AllowAll,
+												},
+											},
+										},
+									},
+								},
+								{
+									ASN:      65013,
+									ID:       "192.0.2.20",
+									VRF:      "red",
+									Prefixes: []string{"192.0.5.0/24", "192.0.6.0/23", "2001:db9::/64"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:    65040,
+						RouterID: "192.0.2.20",
+						IPV4Imports: []frr.ImportConfig{
 							{
-								IPFamily: ipfamily.IPv6,
-								Name:     "65014@2001:db8::4",
-								ASN:      "65014",
-								Addr:     "2001:db8::4",
-								VRFName:  "vrf2",
-								AlwaysBlock: []frr.IncomingFilter{
+								VRF: "red",
+								Prefixes: []frr.IncomingFilter{
 									{
 										IPFamily: ipfamily.IPv4,
-										Prefix:   "192.168.1.0/24",
-										LE:       uint32(32),
-									}, {
-										IPFamily: ipfamily.IPv6,
-										Prefix:   "fc00:f853:ccd:e800::/64",
-										LE:       uint32(128),
+										Prefix:   "192.0.4.0/22",
+										LE:       24,
 									},
 								},
 							},
 						},
-						VRF: "vrf2",
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65041@192.0.2.21",
+								ASN:      "65041",
+								Addr:     "192.0.2.21",
+								Outgoing: frr.AllowedOut{
+									PrefixesV4: []string{"192.0.5.0/24", "192.0.6.0/23"},
+								},
+							},
+						},
+					},
+					{
+						MyASN:        65013,
+						RouterID:     "192.0.2.20",
+						VRF:          "red",
+						IPV4Prefixes: []string{"192.0.5.0/24", "192.0.6.0/23"},
+						IPV6Prefixes: []string{"2001:db9::/64"},
 					},
 				},
 			},
 			err: nil,
 		},
 		{
-			name: "Multiple Routers import VRFs",
+			name: "Multiple Routers filtered import VRF, advertise ips not leaked from the imported vrf",
 			fromK8s: []v1beta1.FRRConfiguration{
 				{
 					Spec: v1beta1.FRRConfigurationSpec{
 						BGP: v1beta1.BGPConfig{
 							Routers: []v1beta1.Router{
 								{
-									ASN: 65010,
-									ID:  "192.0.2.5",
-									VRF: "",
+									ASN: 65040,
 									Imports: []v1beta1.Import{
-										{VRF: "red"},
+										{
+											VRF: "red",
+											Prefixes: []v1beta1.PrefixSelector{
+												{
+													Prefix: "192.0.5.0/24",
+												},
+											},
+										},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											ASN:     65041,
+											Address: "192.0.2.21",
+											ToAdvertise: v1beta1.Advertise{
+												Allowed: v1beta1.AllowedOutPrefixes{
+													Prefixes: []string{"192.0.6.0/24"},
+												},
+											},
+										},
 									},
 								},
 								{
-									ASN: 65013,
-									ID:  "2001:db8::3",
-									VRF: "red",
+									ASN:      65013,
+									VRF:      "red",
+									Prefixes: []string{"192.0.5.0/24", "192.0.6.0/24"},
 								},
 							},
 						},
 					},
 				},
 			},
-			secrets: map[string]v1.Secret{},
-			expected: &frr.Config{
-				Routers: []*frr.RouterConfig{
-					{
-						MyASN:      65010,
-						RouterID:   "192.0.2.5",
-						VRF:        "",
-						ImportVRFs: []string{"red"},
-					},
-					{
-						MyASN:    65013,
-						RouterID: "2001:db8::3",
-						VRF:      "red",
+			err: errors.New("trying to advertise non configured prefix 192.0.6.0/24 to neighbor 65041@192.0.2.21, vrf "),
+		},
+		{
+			name: "Import VRF with prefixes of a family not imported",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65040,
+									Imports: []v1beta1.Import{
+										{
+											VRF:      "red",
+											Families: []v1beta1.ImportFamily{v1beta1.ImportFamilyIPv6},
+											Prefixes: []v1beta1.PrefixSelector{
+												{
+													Prefix: "192.0.5.0/24",
+												},
+											},
+										},
+									},
+								},
+								{
+									ASN: 65013,
+									VRF: "red",
+								},
+							},
+						},
 					},
 				},
 			},
-			err: nil,
+			err: errors.New("router 65040- has invalid import for vrf red: prefix 192.0.5.0/24 specified for a family not imported"),
 		},
 		{
 			name: "Multiple Routers import VRF, advertise ips from the imported vrf",
@@ -2675,7 +6256,8 @@ func TestConversion(t *testing.T) {
 								},
 							},
 						},
-						ImportVRFs:   []string{"red"},
+						IPV4Imports:  []frr.ImportConfig{{VRF: "red"}},
+						IPV6Imports:  []frr.ImportConfig{{VRF: "red"}},
 						IPV4Prefixes: []string{"192.0.2.0/24"},
 						IPV6Prefixes: []string{"2001:db8::/64"},
 					},
@@ -2778,7 +6360,8 @@ func TestConversion(t *testing.T) {
 								},
 							},
 						},
-						ImportVRFs:   []string{"default"},
+						IPV4Imports:  []frr.ImportConfig{{VRF: "default"}},
+						IPV6Imports:  []frr.ImportConfig{{VRF: "default"}},
 						IPV4Prefixes: []string{"192.0.2.0/24"},
 						IPV6Prefixes: []string{"2001:db8::/64"},
 					},
@@ -2875,7 +6458,206 @@ func TestConversion(t *testing.T) {
 				},
 			},
 			secrets: map[string]v1.Secret{},
-			err:     errors.New("neighbor 65010@192.0.2.22: localASN is not supported for iBGP sessions (neighbor ASN 65010 equals router ASN)"),
+			err:     errors.New("router 65010-: neighbor 65010@192.0.2.22: localASN is not supported for iBGP sessions"),
+		},
+		{
+			name: "Router with confederation",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65010,
+									ID:  "192.0.2.5",
+									VRF: "",
+									Confederation: &v1beta1.Confederation{
+										Identifier: 64512,
+										Peers:      []uint32{65012, 65011},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											Address: "192.0.2.22",
+											ASN:     65011,
+										},
+										{
+											Address:  "192.0.2.23",
+											ASN:      65100,
+											LocalASN: 64520,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &frr.Config{
+				Routers: []*frr.RouterConfig{
+					{
+						MyASN:    65010,
+						VRF:      "",
+						RouterID: "192.0.2.5",
+						Confederation: &frr.ConfederationConfig{
+							Identifier: 64512,
+							Peers:      []uint32{65011, 65012},
+						},
+						Neighbors: []*frr.NeighborConfig{
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65011@192.0.2.22",
+								ASN:      "65011",
+								Addr:     "192.0.2.22",
+							},
+							{
+								IPFamily: ipfamily.IPv4,
+								Name:     "65100@192.0.2.23",
+								ASN:      "65100",
+								Addr:     "192.0.2.23",
+								LocalASN: 64520,
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     nil,
+		},
+		{
+			name: "Neighbor with localASN on confederation session is rejected",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65010,
+									ID:  "192.0.2.5",
+									VRF: "",
+									Confederation: &v1beta1.Confederation{
+										Identifier: 64512,
+										Peers:      []uint32{65011},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											Address:  "192.0.2.22",
+											ASN:      65011,
+											LocalASN: 64520,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("router 65010-: neighbor 65011@192.0.2.22: localASN is not supported for confederation sessions"),
+		},
+		{
+			name: "Neighbor with localASN on confederation session from another config is rejected",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65010,
+									ID:  "192.0.2.5",
+									VRF: "",
+									Neighbors: []v1beta1.Neighbor{
+										{
+											Address:  "192.0.2.22",
+											ASN:      65011,
+											LocalASN: 64520,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65010,
+									ID:  "192.0.2.5",
+									VRF: "",
+									Confederation: &v1beta1.Confederation{
+										Identifier: 64512,
+										Peers:      []uint32{65011},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("router 65010-: neighbor 65011@192.0.2.22: localASN is not supported for confederation sessions"),
+		},
+		{
+			name: "Neighbor route reflector client on confederation session is rejected",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65010,
+									ID:  "192.0.2.5",
+									VRF: "",
+									Confederation: &v1beta1.Confederation{
+										Identifier: 64512,
+										Peers:      []uint32{65011},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											Address:              "192.0.2.22",
+											ASN:                  65011,
+											RouteReflectorClient: true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("router 65010-: neighbor 65011@192.0.2.22: routeReflectorClient is not supported for confederation sessions"),
+		},
+		{
+			name: "Confederation including the router asn is rejected",
+			fromK8s: []v1beta1.FRRConfiguration{
+				{
+					Spec: v1beta1.FRRConfigurationSpec{
+						BGP: v1beta1.BGPConfig{
+							Routers: []v1beta1.Router{
+								{
+									ASN: 65010,
+									ID:  "192.0.2.5",
+									VRF: "",
+									Confederation: &v1beta1.Confederation{
+										Identifier: 64512,
+										Peers:      []uint32{65010, 65011},
+									},
+									Neighbors: []v1beta1.Neighbor{
+										{
+											Address: "192.0.2.22",
+											ASN:     65011,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secrets: map[string]v1.Secret{},
+			err:     errors.New("invalid confederation for router 65010-: peer 65010 is the asn of the router"),
 		},
 		{
 			name: "Neighbor with DynamicASN",
@@ -3780,6 +7562,8 @@ func TestConversion(t *testing.T) {
 			resources := ClusterResources{
 				FRRConfigs:      test.fromK8s,
 				PasswordSecrets: test.secrets,
+				PeerTemplates:   test.templates,
+				Node:            test.node,
 			}
 			frr, err := apiToFRR(resources, test.alwaysBlock)
 			if test.err != nil && err == nil {
@@ -34,8 +34,9 @@ var (
 )
 
 const (
-	testNodeName  = "testnode"
-	testNamespace = "testnamespace"
+	testNodeName                   = "testnode"
+	testNamespace                  = "testnamespace"
+	testGracefulShutdownAnnotation = "frrk8s.metallb.io/graceful-shutdown"
 )
 
 func TestAPIs(t *testing.T) {
@@ -86,6 +87,10 @@ var _ = BeforeSuite(func() {
 		Namespace:       testNamespace,
 		ReloadStatus:    fakeReloadStatus,
 		DefaultLogLevel: defaultLogLevel,
+		GracefulShutdown: GracefulShutdownSettings{
+			OnCordon:   true,
+			Annotation: testGracefulShutdownAnnotation,
+		},
 	}).SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
+	"strings"
 	"sync"
 
 	corev1 "k8s.io/api/core/v1"
@@ -42,7 +44,10 @@ import (
 	"github.com/metallb/frr-k8s/internal/logging"
 )
 
-const ConversionSuccess = "success"
+const (
+	ConversionSuccess         = "success"
+	startupProtectionReleased = "released"
+)
 
 // FRRConfigurationReconciler reconciles a FRRConfiguration object.
 type FRRConfigurationReconciler struct {
@@ -53,9 +58,35 @@ type FRRConfigurationReconciler struct {
 	Namespace          string
 	ReloadStatus       func()
 	conversionResult   string
+	startupProtection  string
+	holdUntilReady     []frrk8sv1beta1.HoldUntilReady
 	conversionResMutex sync.Mutex
 	AlwaysBlockCIDRS   []net.IPNet
 	DefaultLogLevel    logging.Level
+	GracefulShutdown   GracefulShutdownSettings
+}
+
+// GracefulShutdownSettings describe when the node is considered under maintenance,
+// in which case all the bgp sessions are gracefully shut down.
+type GracefulShutdownSettings struct {
+	// OnCordon enables the graceful shutdown when the node is cordoned.
+	OnCordon bool
+	// Annotation, when not empty, enables the graceful shutdown when the node
+	// carries an annotation with this name.
+	Annotation string
+}
+
+// nodeUnderMaintenance tells if the given node is cordoned or annotated
+// according to the settings.
+func (s GracefulShutdownSettings) nodeUnderMaintenance(node *corev1.Node) bool {
+	if s.OnCordon && node.Spec.Unschedulable {
+		return true
+	}
+	if s.Annotation == "" {
+		return false
+	}
+	_, ok := node.Annotations[s.Annotation]
+	return ok
 }
 
 func (r *FRRConfigurationReconciler) ConversionResult() string {
@@ -64,6 +95,20 @@ func (r *FRRConfigurationReconciler) ConversionResult() string {
 	return r.conversionResult
 }
 
+func (r *FRRConfigurationReconciler) StartupProtection() string {
+	r.conversionResMutex.Lock()
+	defer r.conversionResMutex.Unlock()
+	return r.startupProtection
+}
+
+// holdUntilReadyEntries returns the startup protection entries of the
+// configurations applied to the node by the last reconciliation.
+func (r *FRRConfigurationReconciler) holdUntilReadyEntries() []frrk8sv1beta1.HoldUntilReady {
+	r.conversionResMutex.Lock()
+	defer r.conversionResMutex.Unlock()
+	return r.holdUntilReady
+}
+
 // +kubebuilder:rbac:groups=frrk8s.metallb.io,resources=frrconfigurations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=frrk8s.metallb.io,resources=frrconfigurations/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=frrk8s.metallb.io,resources=frrconfigurations/finalizers,verbs=update
@@ -71,6 +116,7 @@ func (r *FRRConfigurationReconciler) ConversionResult() string {
 // +kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=validatingwebhookconfigurations,verbs=get;list;watch
 // +kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=validatingwebhookconfigurations,resourceNames="frr-k8s-validating-webhook-configuration",verbs=update
 // +kubebuilder:rbac:groups=frrk8s.metallb.io,resources=frrk8sconfigurations,verbs=get;list;watch
+// +kubebuilder:rbac:groups=frrk8s.metallb.io,resources=bgppeertemplates,verbs=get;list;watch
 
 func (r *FRRConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	l := logging.GetLogger()
@@ -80,12 +126,17 @@ func (r *FRRConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	lastConversionResult := r.conversionResult
 	conversionResult := ConversionSuccess
+	lastStartupProtection := r.startupProtection
+	startupProtection := lastStartupProtection
+	holdUntilReady := r.holdUntilReadyEntries()
 
 	defer func() {
 		r.conversionResMutex.Lock()
 		r.conversionResult = conversionResult
+		r.startupProtection = startupProtection
+		r.holdUntilReady = holdUntilReady
 		r.conversionResMutex.Unlock()
-		if conversionResult != lastConversionResult {
+		if conversionResult != lastConversionResult || startupProtection != lastStartupProtection {
 			r.ReloadStatus()
 		}
 	}()
@@ -114,6 +165,8 @@ func (r *FRRConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	level.Debug(l).Log("controller", "FRRConfigurationReconciler", "log level FRR", logLevel)
 
 	if len(configs.Items) == 0 {
+		startupProtection = ""
+		holdUntilReady = nil
 		err := r.applyEmptyConfig(logLevel)
 		if err != nil {
 			updateErrors.Inc()
@@ -137,6 +190,7 @@ func (r *FRRConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		conversionResult = fmt.Sprintf("failed: %v", err)
 		return ctrl.Result{}, err
 	}
+	holdUntilReady = holdUntilReadyFor(cfgs)
 
 	secrets, err := r.getSecrets(ctx)
 	if err != nil {
@@ -144,9 +198,17 @@ func (r *FRRConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
+	peerTemplates, err := r.getPeerTemplates(ctx)
+	if err != nil {
+		conversionResult = fmt.Sprintf("failed: %v", err)
+		return ctrl.Result{}, err
+	}
+
 	resources := ClusterResources{
 		FRRConfigs:      cfgs,
 		PasswordSecrets: secrets,
+		PeerTemplates:   peerTemplates,
+		Node:            thisNode,
 	}
 	config, err := apiToFRR(resources, r.AlwaysBlockCIDRS)
 	if err != nil {
@@ -156,6 +218,11 @@ func (r *FRRConfigurationReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		conversionResult = fmt.Sprintf("failed: %v", err)
 		return ctrl.Result{}, nil
 	}
+	startupProtection = startupProtectionState(resources)
+	config.GracefulShutdown = r.GracefulShutdown.nodeUnderMaintenance(thisNode)
+	if config.GracefulShutdown {
+		level.Info(l).Log("controller", "FRRConfigurationReconciler", "event", "node under maintenance, shutting down the bgp sessions gracefully")
+	}
 
 	frrDump := ""
 	if l.GetLogLevel().IsAllOrDebug() {
@@ -200,6 +267,26 @@ func (r *FRRConfigurationReconciler) applyEmptyConfig(logLevel logging.Level) er
 	return nil
 }
 
+// startupProtectionState tells whether the routers holding their advertisements
+// until the node is ready are still holding them.
+func startupProtectionState(resources ClusterResources) string {
+	if !hasHoldUntilReady(resources.FRRConfigs) {
+		return ""
+	}
+	held := heldVRFs(resources)
+	if len(held) == 0 {
+		return startupProtectionReleased
+	}
+	vrfs := make([]string, 0, len(held))
+	for _, vrf := range held {
+		if vrf == "" {
+			vrf = "default"
+		}
+		vrfs = append(vrfs, vrf)
+	}
+	return fmt.Sprintf("holding the advertisements of vrfs %s until the node is ready", strings.Join(vrfs, ","))
+}
+
 // configsForNode filters the given FRRConfigurations such that only the ones matching the given labels are returned.
 // This also validates that the configuration objects have a valid nodeSelector.
 func configsForNode(cfgs []frrk8sv1beta1.FRRConfiguration, nodeLabels map[string]string) ([]frrk8sv1beta1.FRRConfiguration, error) {
@@ -224,7 +311,7 @@ func configsForNode(cfgs []frrk8sv1beta1.FRRConfiguration, nodeLabels map[string
 func (r *FRRConfigurationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	p := predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return filterNodeEvent(e, r.NodeName)
+			return filterNodeEvent(e, r.NodeName, r.GracefulShutdown, r.holdUntilReadyEntries())
 		},
 	}
 
@@ -245,6 +332,7 @@ func (r *FRRConfigurationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&corev1.Node{}).
 		Watches(&corev1.Secret{}, &handler.EnqueueRequestForObject{}).
 		Watches(&frrk8sv1beta1.FRRK8sConfiguration{}, &handler.EnqueueRequestForObject{}).
+		Watches(&frrk8sv1beta1.BGPPeerTemplate{}, &handler.EnqueueRequestForObject{}).
 		WithEventFilter(p).
 		Complete(r)
 }
@@ -267,7 +355,22 @@ func (r *FRRConfigurationReconciler) getSecrets(ctx context.Context) (map[string
 	return secretsMap, nil
 }
 
-func filterNodeEvent(e event.UpdateEvent, thisNode string) bool {
+func (r *FRRConfigurationReconciler) getPeerTemplates(ctx context.Context) (map[string]frrk8sv1beta1.BGPPeerTemplate, error) {
+	var templates frrk8sv1beta1.BGPPeerTemplateList
+	l := logging.GetLogger()
+	err := r.List(ctx, &templates, client.InNamespace(r.Namespace))
+	if err != nil {
+		level.Error(l).Log("controller", "FRRConfigurationReconciler", "error", "failed to get peer templates", "error", err)
+		return nil, err
+	}
+	templatesMap := make(map[string]frrk8sv1beta1.BGPPeerTemplate)
+	for _, t := range templates.Items {
+		templatesMap[t.Name] = t
+	}
+	return templatesMap, nil
+}
+
+func filterNodeEvent(e event.UpdateEvent, thisNode string, gracefulShutdown GracefulShutdownSettings, holdUntilReady []frrk8sv1beta1.HoldUntilReady) bool {
 	newNodeObj, ok := e.ObjectNew.(*corev1.Node)
 	if !ok {
 		return true
@@ -288,8 +391,13 @@ func filterNodeEvent(e event.UpdateEvent, thisNode string) bool {
 		return false
 	}
 
-	// Ignoring event if it didn't change the node's labels
-	if labels.Equals(labels.Set(oldNodeObj.Labels), labels.Set(newNodeObj.Labels)) {
+	// Ignoring event if it didn't change the node's labels, its maintenance state
+	// nor its readiness according to the startup protection
+	if labels.Equals(labels.Set(oldNodeObj.Labels), labels.Set(newNodeObj.Labels)) &&
+		gracefulShutdown.nodeUnderMaintenance(oldNodeObj) == gracefulShutdown.nodeUnderMaintenance(newNodeObj) &&
+		!slices.ContainsFunc(holdUntilReady, func(h frrk8sv1beta1.HoldUntilReady) bool {
+			return nodeReady(oldNodeObj, &h) != nodeReady(newNodeObj, &h)
+		}) {
 		return false
 	}
 
@@ -19,6 +19,7 @@ package controller
 import (
 	"context"
 	"fmt"
+	"testing"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 
 	v1beta1 "github.com/metallb/frr-k8s/api/v1beta1"
 	"github.com/metallb/frr-k8s/internal/frr"
@@ -111,13 +113,18 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				&frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						Neighbors:    []*frr.NeighborConfig{},
-						ImportVRFs:   []string{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						Neighbors:      []*frr.NeighborConfig{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 		})
@@ -145,13 +152,18 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				&frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						Neighbors:    []*frr.NeighborConfig{},
-						ImportVRFs:   []string{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						Neighbors:      []*frr.NeighborConfig{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -165,13 +177,18 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				&frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(43),
-						IPV4Prefixes: []string{"192.168.1.0/32"},
-						IPV6Prefixes: []string{},
-						Neighbors:    []*frr.NeighborConfig{},
-						ImportVRFs:   []string{},
+						IPV4Prefixes:   []string{"192.168.1.0/32"},
+						IPV6Prefixes:   []string{},
+						Neighbors:      []*frr.NeighborConfig{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -200,13 +217,18 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				&frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						Neighbors:    []*frr.NeighborConfig{},
-						ImportVRFs:   []string{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						Neighbors:      []*frr.NeighborConfig{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -216,9 +238,10 @@ var _ = Describe("Frrk8s controller", func() {
 				return fakeFRRConfigHandler.lastConfig
 			}).Should(Equal(
 				&frr.Config{
-					Routers:     []*frr.RouterConfig{},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					Routers:      []*frr.RouterConfig{},
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 		})
@@ -293,23 +316,32 @@ var _ = Describe("Frrk8s controller", func() {
 				&frr.Config{
 					Routers: []*frr.RouterConfig{
 						{
-							MyASN:        uint32(42),
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(42),
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 						{
-							MyASN:        uint32(52),
-							VRF:          "red",
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(52),
+							VRF:            "red",
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 					},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -327,31 +359,44 @@ var _ = Describe("Frrk8s controller", func() {
 				&frr.Config{
 					Routers: []*frr.RouterConfig{
 						{
-							MyASN:        uint32(42),
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(42),
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 						{
-							MyASN:        uint32(62),
-							VRF:          "blue",
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(62),
+							VRF:            "blue",
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 						{
-							MyASN:        uint32(52),
-							VRF:          "red",
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(52),
+							VRF:            "red",
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 					},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -366,23 +411,32 @@ var _ = Describe("Frrk8s controller", func() {
 				&frr.Config{
 					Routers: []*frr.RouterConfig{
 						{
-							MyASN:        uint32(42),
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(42),
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 						{
-							MyASN:        uint32(62),
-							VRF:          "blue",
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(62),
+							VRF:            "blue",
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 					},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 		})
@@ -435,15 +489,20 @@ var _ = Describe("Frrk8s controller", func() {
 				&frr.Config{
 					Routers: []*frr.RouterConfig{
 						{
-							MyASN:        uint32(42),
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(42),
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 					},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -463,23 +522,32 @@ var _ = Describe("Frrk8s controller", func() {
 				&frr.Config{
 					Routers: []*frr.RouterConfig{
 						{
-							MyASN:        uint32(42),
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(42),
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 						{
-							MyASN:        uint32(52),
-							VRF:          "red",
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(52),
+							VRF:            "red",
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 					},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -495,19 +563,85 @@ var _ = Describe("Frrk8s controller", func() {
 				&frr.Config{
 					Routers: []*frr.RouterConfig{
 						{
-							MyASN:        uint32(42),
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          uint32(42),
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 						},
 					},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 		})
 
+		It("should shut down the sessions gracefully while the node is under maintenance", func() {
+			config := &v1beta1.FRRConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "default",
+				},
+				Spec: v1beta1.FRRConfigurationSpec{
+					BGP: v1beta1.BGPConfig{
+						Routers: []v1beta1.Router{
+							{
+								ASN: uint32(42),
+							},
+						},
+					},
+				},
+			}
+			err := k8sClient.Create(context.Background(), config)
+			Expect(err).ToNot(HaveOccurred())
+
+			gracefulShutdown := func() bool {
+				if fakeFRRConfigHandler.lastConfig == nil || len(fakeFRRConfigHandler.lastConfig.Routers) == 0 {
+					return false
+				}
+				return fakeFRRConfigHandler.lastConfig.GracefulShutdown
+			}
+			Eventually(func() int {
+				if fakeFRRConfigHandler.lastConfig == nil {
+					return 0
+				}
+				return len(fakeFRRConfigHandler.lastConfig.Routers)
+			}).Should(Equal(1))
+			Expect(gracefulShutdown()).To(BeFalse())
+
+			By("Cordoning the node")
+			node := &corev1.Node{}
+			err = k8sClient.Get(ctx, types.NamespacedName{Name: testNodeName}, node)
+			Expect(err).ToNot(HaveOccurred())
+			node.Spec.Unschedulable = true
+			err = k8sClient.Update(context.Background(), node)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(gracefulShutdown).Should(BeTrue())
+
+			By("Uncordoning the node")
+			node.Spec.Unschedulable = false
+			err = k8sClient.Update(context.Background(), node)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(gracefulShutdown).Should(BeFalse())
+
+			By("Annotating the node")
+			node.Annotations = map[string]string{testGracefulShutdownAnnotation: ""}
+			err = k8sClient.Update(context.Background(), node)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(gracefulShutdown).Should(BeTrue())
+
+			By("Removing the annotation from the node")
+			node.Annotations = map[string]string{}
+			err = k8sClient.Update(context.Background(), node)
+			Expect(err).ToNot(HaveOccurred())
+			Eventually(gracefulShutdown).Should(BeFalse())
+		})
+
 		It("should handle the secrets as passwords to FRR", func() {
 			frrConfig := &v1beta1.FRRConfiguration{
 				ObjectMeta: ctrl.ObjectMeta{
@@ -556,9 +690,13 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						ImportVRFs:   []string{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
 						Neighbors: []*frr.NeighborConfig{
 							{
 								IPFamily: ipfamily.IPv4,
@@ -567,22 +705,32 @@ var _ = Describe("Frrk8s controller", func() {
 								Addr:     "192.0.2.7",
 								Password: "password2",
 								Outgoing: frr.AllowedOut{
-									PrefixesV4:                 []string{},
-									PrefixesV6:                 []string{},
-									LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
-									CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+									PrefixesV4:                     []string{},
+									PrefixesV6:                     []string{},
+									LocalPrefPrefixesModifiers:     []frr.LocalPrefPrefixList{},
+									CommunityPrefixesModifiers:     []frr.CommunityPrefixList{},
+									ASPathPrependPrefixesModifiers: []frr.ASPathPrependPrefixList{},
+									MEDPrefixesModifiers:           []frr.MEDPrefixList{},
+									OriginPrefixesModifiers:        []frr.OriginPrefixList{},
 								},
 								Incoming: frr.AllowedIn{
-									PrefixesV4: []frr.IncomingFilter{},
-									PrefixesV6: []frr.IncomingFilter{},
+									PrefixesV4:                 []frr.IncomingFilter{},
+									PrefixesV6:                 []frr.IncomingFilter{},
+									Communities:                []string{},
+									LargeCommunities:           []string{},
+									ASPaths:                    []string{},
+									LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+									CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+									WeightPrefixesModifiers:    []frr.WeightPrefixList{},
 								},
 								AlwaysBlock:     []frr.IncomingFilter{},
 								AddressFamilies: []string{"unicast"},
 							},
 						},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -597,9 +745,13 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						ImportVRFs:   []string{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
 						Neighbors: []*frr.NeighborConfig{
 							{
 								IPFamily: ipfamily.IPv4,
@@ -608,22 +760,32 @@ var _ = Describe("Frrk8s controller", func() {
 								Addr:     "192.0.2.7",
 								Password: "password3",
 								Outgoing: frr.AllowedOut{
-									PrefixesV4:                 []string{},
-									PrefixesV6:                 []string{},
-									LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
-									CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+									PrefixesV4:                     []string{},
+									PrefixesV6:                     []string{},
+									LocalPrefPrefixesModifiers:     []frr.LocalPrefPrefixList{},
+									CommunityPrefixesModifiers:     []frr.CommunityPrefixList{},
+									ASPathPrependPrefixesModifiers: []frr.ASPathPrependPrefixList{},
+									MEDPrefixesModifiers:           []frr.MEDPrefixList{},
+									OriginPrefixesModifiers:        []frr.OriginPrefixList{},
 								},
 								Incoming: frr.AllowedIn{
-									PrefixesV4: []frr.IncomingFilter{},
-									PrefixesV6: []frr.IncomingFilter{},
+									PrefixesV4:                 []frr.IncomingFilter{},
+									PrefixesV6:                 []frr.IncomingFilter{},
+									Communities:                []string{},
+									LargeCommunities:           []string{},
+									ASPaths:                    []string{},
+									LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+									CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+									WeightPrefixesModifiers:    []frr.WeightPrefixList{},
 								},
 								AlwaysBlock:     []frr.IncomingFilter{},
 								AddressFamilies: []string{"unicast"},
 							},
 						},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 		})
@@ -654,14 +816,19 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				&frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						ImportVRFs:   []string{},
-						Neighbors:    []*frr.NeighborConfig{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
+						Neighbors:      []*frr.NeighborConfig{},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					ExtraConfig: "foo\n",
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					ExtraConfig:  "foo\n",
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -687,14 +854,19 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				&frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						ImportVRFs:   []string{},
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						Neighbors:    []*frr.NeighborConfig{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						Neighbors:      []*frr.NeighborConfig{},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					ExtraConfig: "foo\nbar\n",
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					ExtraConfig:  "foo\nbar\n",
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -704,10 +876,11 @@ var _ = Describe("Frrk8s controller", func() {
 				return fakeFRRConfigHandler.lastConfig
 			}).Should(Equal(
 				&frr.Config{
-					Routers:     []*frr.RouterConfig{},
-					BFDProfiles: []frr.BFDProfile{},
-					ExtraConfig: "bar\n",
-					Loglevel:    frr.LevelFrom(logLevel),
+					Routers:      []*frr.RouterConfig{},
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					ExtraConfig:  "bar\n",
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 		})
@@ -748,11 +921,16 @@ var _ = Describe("Frrk8s controller", func() {
 			}).Should(Equal(
 				&frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						ImportVRFs:   []string{},
-						Neighbors:    []*frr.NeighborConfig{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
+						Neighbors:      []*frr.NeighborConfig{},
 					}},
+					StaticRoutes: []frr.StaticRouteConfig{},
 					BFDProfiles: []frr.BFDProfile{
 						{
 							Name:             "bar",
@@ -891,10 +1069,14 @@ var _ = Describe("Frrk8s controller", func() {
 					Loglevel: frr.LevelFrom(logLevel),
 					Routers: []*frr.RouterConfig{
 						{
-							MyASN:        65000,
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							ImportVRFs:   []string{},
+							MyASN:          65000,
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 							Neighbors: []*frr.NeighborConfig{
 								{
 									IPFamily:        ipfamily.IPv4,
@@ -903,14 +1085,23 @@ var _ = Describe("Frrk8s controller", func() {
 									Addr:            "192.0.2.10",
 									AddressFamilies: []string{"evpn", "unicast"},
 									Outgoing: frr.AllowedOut{
-										PrefixesV4:                 []string{},
-										PrefixesV6:                 []string{},
-										LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
-										CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+										PrefixesV4:                     []string{},
+										PrefixesV6:                     []string{},
+										LocalPrefPrefixesModifiers:     []frr.LocalPrefPrefixList{},
+										CommunityPrefixesModifiers:     []frr.CommunityPrefixList{},
+										ASPathPrependPrefixesModifiers: []frr.ASPathPrependPrefixList{},
+										MEDPrefixesModifiers:           []frr.MEDPrefixList{},
+										OriginPrefixesModifiers:        []frr.OriginPrefixList{},
 									},
 									Incoming: frr.AllowedIn{
-										PrefixesV4: []frr.IncomingFilter{},
-										PrefixesV6: []frr.IncomingFilter{},
+										PrefixesV4:                 []frr.IncomingFilter{},
+										PrefixesV6:                 []frr.IncomingFilter{},
+										Communities:                []string{},
+										LargeCommunities:           []string{},
+										ASPaths:                    []string{},
+										LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+										CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+										WeightPrefixesModifiers:    []frr.WeightPrefixList{},
 									},
 									AlwaysBlock: []frr.IncomingFilter{},
 								},
@@ -927,12 +1118,16 @@ var _ = Describe("Frrk8s controller", func() {
 							},
 						},
 						{
-							MyASN:        65000,
-							VRF:          "red",
-							IPV4Prefixes: []string{},
-							IPV6Prefixes: []string{},
-							Neighbors:    []*frr.NeighborConfig{},
-							ImportVRFs:   []string{},
+							MyASN:          65000,
+							VRF:            "red",
+							IPV4Prefixes:   []string{},
+							IPV6Prefixes:   []string{},
+							Neighbors:      []*frr.NeighborConfig{},
+							IPV4Imports:    []frr.ImportConfig{},
+							IPV6Imports:    []frr.ImportConfig{},
+							IPV4Aggregates: []frr.AggregateConfig{},
+							IPV6Aggregates: []frr.AggregateConfig{},
+							Redistribute:   []frr.RedistributeConfig{},
 							EVPN: &frr.EVPNConfig{
 								L3VNI: &frr.L3VNI{
 									VNI: 3000, VNIProperties: frr.VNIProperties{
@@ -945,7 +1140,8 @@ var _ = Describe("Frrk8s controller", func() {
 							},
 						},
 					},
-					BFDProfiles: []frr.BFDProfile{},
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
 				},
 			))
 		})
@@ -1009,3 +1205,76 @@ var _ = Describe("Frrk8s controller", func() {
 	})
 
 })
+
+func TestFilterNodeEvent(t *testing.T) {
+	gracefulShutdown := GracefulShutdownSettings{Annotation: "maintenance"}
+	holdUntilReady := []v1beta1.HoldUntilReady{
+		{Annotation: "ready"},
+		{ConditionType: "NetworkReady"},
+	}
+	node := func(annotations map[string]string, conditions ...corev1.NodeCondition) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "node",
+				Annotations: annotations,
+			},
+			Status: corev1.NodeStatus{
+				Conditions: conditions,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		old      *corev1.Node
+		new      *corev1.Node
+		expected bool
+	}{
+		{
+			name:     "unrelated annotation changed",
+			old:      node(map[string]string{"foo": "bar"}),
+			new:      node(map[string]string{"foo": "baz"}),
+			expected: false,
+		},
+		{
+			name:     "unrelated condition changed",
+			old:      node(nil, corev1.NodeCondition{Type: "DiskPressure", Status: corev1.ConditionFalse}),
+			new:      node(nil, corev1.NodeCondition{Type: "DiskPressure", Status: corev1.ConditionTrue}),
+			expected: false,
+		},
+		{
+			name: "heartbeat of the hold until ready condition",
+			old: node(nil, corev1.NodeCondition{Type: "NetworkReady", Status: corev1.ConditionTrue,
+				LastHeartbeatTime: metav1.NewTime(time.Unix(1, 0))}),
+			new: node(nil, corev1.NodeCondition{Type: "NetworkReady", Status: corev1.ConditionTrue,
+				LastHeartbeatTime: metav1.NewTime(time.Unix(2, 0))}),
+			expected: false,
+		},
+		{
+			name:     "hold until ready annotation changed",
+			old:      node(nil),
+			new:      node(map[string]string{"ready": "true"}),
+			expected: true,
+		},
+		{
+			name:     "hold until ready condition changed",
+			old:      node(nil, corev1.NodeCondition{Type: "NetworkReady", Status: corev1.ConditionFalse}),
+			new:      node(nil, corev1.NodeCondition{Type: "NetworkReady", Status: corev1.ConditionTrue}),
+			expected: true,
+		},
+		{
+			name:     "graceful shutdown annotation added",
+			old:      node(nil),
+			new:      node(map[string]string{"maintenance": ""}),
+			expected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := event.UpdateEvent{ObjectOld: tc.old, ObjectNew: tc.new}
+			if res := filterNodeEvent(e, "node", gracefulShutdown, holdUntilReady); res != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, res)
+			}
+		})
+	}
+}
@@ -112,13 +112,18 @@ var _ = Describe("Frrk8s logging", Serial, func() {
 			}).Should(Equal(
 				&frr.Config{
 					Routers: []*frr.RouterConfig{{MyASN: uint32(42),
-						IPV4Prefixes: []string{},
-						IPV6Prefixes: []string{},
-						Neighbors:    []*frr.NeighborConfig{},
-						ImportVRFs:   []string{},
+						IPV4Prefixes:   []string{},
+						IPV6Prefixes:   []string{},
+						Neighbors:      []*frr.NeighborConfig{},
+						IPV4Imports:    []frr.ImportConfig{},
+						IPV6Imports:    []frr.ImportConfig{},
+						IPV4Aggregates: []frr.AggregateConfig{},
+						IPV6Aggregates: []frr.AggregateConfig{},
+						Redistribute:   []frr.RedistributeConfig{},
 					}},
-					BFDProfiles: []frr.BFDProfile{},
-					Loglevel:    frr.LevelFrom(logLevel),
+					StaticRoutes: []frr.StaticRouteConfig{},
+					BFDProfiles:  []frr.BFDProfile{},
+					Loglevel:     frr.LevelFrom(logLevel),
 				},
 			))
 
@@ -74,6 +74,7 @@ func NewStateEvent() event.GenericEvent {
 
 type ConversionResultFetcher interface {
 	ConversionResult() string
+	StartupProtection() string
 }
 
 // +kubebuilder:rbac:groups=frrk8s.metallb.io,resources=frrnodestates,verbs=get;list;watch;create;update;patch;delete
@@ -103,6 +104,7 @@ func (r *FRRStateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		RunningConfig:        cleanPasswords(frrStatus.Current),
 		LastReloadResult:     cleanPasswords(frrStatus.LastReloadResult),
 		LastConversionResult: r.ConversionResult.ConversionResult(),
+		StartupProtection:    r.ConversionResult.StartupProtection(),
 	}
 	if reflect.DeepEqual(state.Status, newStatus) { // Do nothing
 		return ctrl.Result{}, nil
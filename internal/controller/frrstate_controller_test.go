@@ -52,13 +52,18 @@ func (f *fakeFRRStatus) GetStatus() frr.Status {
 }
 
 type fakeConversionResult struct {
-	result string
+	result            string
+	startupProtection string
 }
 
 func (f *fakeConversionResult) ConversionResult() string {
 	return f.result
 }
 
+func (f *fakeConversionResult) StartupProtection() string {
+	return f.startupProtection
+}
+
 var _ = Describe("Frrk8s node status", func() {
 	Context("when a FRRConfiguration is created", func() {
 
@@ -146,6 +151,28 @@ var _ = Describe("Frrk8s node status", func() {
 					}),
 				}))
 
+			fakeConversionRes.startupProtection = "released"
+			updateChan <- NewStateEvent()
+
+			Eventually(func() frrk8sv1beta1.FRRNodeState {
+				nodeStatusList := frrk8sv1beta1.FRRNodeStateList{}
+				err := k8sClient.List(context.Background(), &nodeStatusList)
+				Expect(err).ToNot(HaveOccurred())
+				if len(nodeStatusList.Items) != 1 {
+					return frrk8sv1beta1.FRRNodeState{}
+				}
+				return nodeStatusList.Items[0]
+			}, time.Minute, 5*time.Second).Should(
+				gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"ObjectMeta": gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+						"Name": Equal(testNodeName),
+					}),
+					"Status": gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+						"LastConversionResult": Equal("aaaa"),
+						"StartupProtection":    Equal("released"),
+					}),
+				}))
+
 		})
 
 		It("should obfuscate the passwords", func() {
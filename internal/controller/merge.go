@@ -4,7 +4,9 @@ package controller
 
 import (
 	"fmt"
+	"reflect"
 	"slices"
+	"strings"
 
 	v1beta1 "github.com/metallb/frr-k8s/api/v1beta1"
 	"github.com/metallb/frr-k8s/internal/frr"
@@ -30,9 +32,54 @@ func mergeRouterConfigs(r, toMerge *frr.RouterConfig) (*frr.RouterConfig, error)
 		r.RouterID = toMerge.RouterID
 	}
 
+	if r.ClusterID == "" {
+		r.ClusterID = toMerge.ClusterID
+	}
+
+	if r.BestPath == nil {
+		r.BestPath = toMerge.BestPath
+	}
+
+	if r.ListenLimit == nil {
+		r.ListenLimit = toMerge.ListenLimit
+	}
+
+	if r.StartupProtection == nil {
+		r.StartupProtection = toMerge.StartupProtection
+	}
+
+	if r.GracefulRestart == nil {
+		r.GracefulRestart = toMerge.GracefulRestart
+	}
+
+	if r.Confederation == nil {
+		r.Confederation = toMerge.Confederation
+	}
+
 	v4Prefixes := sets.New(append(r.IPV4Prefixes, toMerge.IPV4Prefixes...)...)
 	v6Prefixes := sets.New(append(r.IPV6Prefixes, toMerge.IPV6Prefixes...)...)
-	importVRFs := sets.New(append(r.ImportVRFs, toMerge.ImportVRFs...)...)
+	v4Imports, err := mergeImports(r.IPV4Imports, toMerge.IPV4Imports)
+	if err != nil {
+		return nil, fmt.Errorf("%w for same vrf: %s", err, r.VRF)
+	}
+	v6Imports, err := mergeImports(r.IPV6Imports, toMerge.IPV6Imports)
+	if err != nil {
+		return nil, fmt.Errorf("%w for same vrf: %s", err, r.VRF)
+	}
+
+	v4Aggregates, err := mergeAggregates(r.IPV4Aggregates, toMerge.IPV4Aggregates)
+	if err != nil {
+		return nil, fmt.Errorf("%w for same vrf: %s", err, r.VRF)
+	}
+	v6Aggregates, err := mergeAggregates(r.IPV6Aggregates, toMerge.IPV6Aggregates)
+	if err != nil {
+		return nil, fmt.Errorf("%w for same vrf: %s", err, r.VRF)
+	}
+
+	redistribute, err := mergeRedistribute(r.Redistribute, toMerge.Redistribute)
+	if err != nil {
+		return nil, fmt.Errorf("%w for same vrf: %s", err, r.VRF)
+	}
 
 	mergedNeighbors, err := mergeNeighborsLists(r.Neighbors, toMerge.Neighbors)
 	if err != nil {
@@ -46,13 +93,74 @@ func mergeRouterConfigs(r, toMerge *frr.RouterConfig) (*frr.RouterConfig, error)
 
 	r.IPV4Prefixes = sets.List(v4Prefixes)
 	r.IPV6Prefixes = sets.List(v6Prefixes)
-	r.ImportVRFs = sets.List(importVRFs)
+	r.IPV4Imports = v4Imports
+	r.IPV6Imports = v6Imports
+	r.IPV4Aggregates = v4Aggregates
+	r.IPV6Aggregates = v6Aggregates
+	r.Redistribute = redistribute
 	r.Neighbors = mergedNeighbors
 	r.EVPN = mergedEVPN
 
 	return r, nil
 }
 
+// mergeImports merges two import lists of the same router, returning
+// an error if the same vrf is imported with different filters.
+func mergeImports(curr, toMerge []frr.ImportConfig) ([]frr.ImportConfig, error) {
+	all := map[string]frr.ImportConfig{}
+	for _, i := range slices.Concat(curr, toMerge) {
+		existing, ok := all[i.VRF]
+		if ok && !reflect.DeepEqual(existing, i) {
+			return nil, fmt.Errorf("different import filters specified for vrf %s", i.VRF)
+		}
+		all[i.VRF] = i
+	}
+	return sortMap(all), nil
+}
+
+// mergeAggregates merges two aggregate lists of the same router, returning
+// an error if the same prefix is aggregated with different settings.
+func mergeAggregates(curr, toMerge []frr.AggregateConfig) ([]frr.AggregateConfig, error) {
+	all := map[string]frr.AggregateConfig{}
+	for _, a := range slices.Concat(curr, toMerge) {
+		existing, ok := all[a.Prefix]
+		if ok && existing != a {
+			return nil, fmt.Errorf("different aggregate settings specified for prefix %s", a.Prefix)
+		}
+		all[a.Prefix] = a
+	}
+	return sortMap(all), nil
+}
+
+// mergeStaticRoute adds the given static route to the ones collected so far,
+// keyed by vrf, prefix and next hop. Routes towards different next hops of the
+// same prefix are all kept, while the same route with different settings is
+// an error.
+func mergeStaticRoute(routes map[string]frr.StaticRouteConfig, r frr.StaticRouteConfig) error {
+	key := strings.Join([]string{r.VRF, r.Prefix, r.NextHop, r.Interface, r.NextHopVRF}, "|")
+	existing, ok := routes[key]
+	if ok && !reflect.DeepEqual(existing, r) {
+		via := strings.TrimSpace(r.NextHop + " " + r.Interface)
+		return fmt.Errorf("different settings specified for static route %s via %s in vrf %q", r.Prefix, via, r.VRF)
+	}
+	routes[key] = r
+	return nil
+}
+
+// mergeRedistribute merges two redistribute lists of the same router, returning
+// an error if the same source is redistributed with different settings.
+func mergeRedistribute(curr, toMerge []frr.RedistributeConfig) ([]frr.RedistributeConfig, error) {
+	all := map[string]frr.RedistributeConfig{}
+	for _, r := range slices.Concat(curr, toMerge) {
+		existing, ok := all[r.RouteMapName()]
+		if ok && !reflect.DeepEqual(existing, r) {
+			return nil, fmt.Errorf("different redistribute settings specified for source %s", r.Command())
+		}
+		all[r.RouteMapName()] = r
+	}
+	return sortMap(all), nil
+}
+
 // mergeNeighborsLists merges two neighbor configuration slices corresponding to the same router.
 // It combines both slices and merges neighbors with the same ID (address+VRF combination).
 // Returns a sorted list of merged neighbor configurations or an error if neighbors are incompatible.
@@ -89,11 +197,31 @@ func mergeIntoNeighbor(dest, src *frr.NeighborConfig) error {
 		dest.BFDProfile = src.BFDProfile
 	}
 
+	if dest.MaxPrefixes == nil {
+		dest.MaxPrefixes = src.MaxPrefixes
+	}
+
+	// a neighbor is a route reflector client if any of the configurations asks for it
+	dest.RouteReflectorClient = dest.RouteReflectorClient || src.RouteReflectorClient
+
+	// likewise, a neighbor is shut down if any of the configurations asks for it
+	dest.Shutdown = dest.Shutdown || src.Shutdown
+	if dest.ShutdownMessage == "" {
+		dest.ShutdownMessage = src.ShutdownMessage
+	}
+
+	if dest.Description == "" {
+		dest.Description = src.Description
+	}
+
 	dest.Outgoing, err = mergeAllowedOut(dest.Outgoing, src.Outgoing)
 	if err != nil {
 		return fmt.Errorf("could not merge outgoing for neighbor %s vrf %s, err: %w", src.Addr, src.VRFName, err)
 	}
-	dest.Incoming = mergeAllowedIn(dest.Incoming, src.Incoming)
+	dest.Incoming, err = mergeAllowedIn(dest.Incoming, src.Incoming)
+	if err != nil {
+		return fmt.Errorf("could not merge incoming for neighbor %s vrf %s, err: %w", src.Addr, src.VRFName, err)
+	}
 	dest.AddressFamilies = sets.List(sets.New(append(dest.AddressFamilies, src.AddressFamilies...)...))
 
 	cleanNeighborDefaults(dest)
@@ -109,8 +237,9 @@ func mergeAllowedOut(r, toMerge frr.AllowedOut) (frr.AllowedOut, error) {
 	mergedPrefixesV6.Insert(toMerge.PrefixesV6...)
 
 	res := frr.AllowedOut{
-		PrefixesV4: sets.List(mergedPrefixesV4),
-		PrefixesV6: sets.List(mergedPrefixesV6),
+		PrefixesV4:    sets.List(mergedPrefixesV4),
+		PrefixesV6:    sets.List(mergedPrefixesV6),
+		Redistributed: r.Redistributed || toMerge.Redistributed,
 	}
 	var err error
 	res.NextHopV4, err = mergeNextHop(r.NextHopV4, toMerge.NextHopV4)
@@ -121,6 +250,22 @@ func mergeAllowedOut(r, toMerge frr.AllowedOut) (frr.AllowedOut, error) {
 	if err != nil {
 		return frr.AllowedOut{}, fmt.Errorf("ipv6 next hop: %w", err)
 	}
+	res.DefaultOriginateV4, err = mergeDefaultOriginate(r.DefaultOriginateV4, toMerge.DefaultOriginateV4)
+	if err != nil {
+		return frr.AllowedOut{}, fmt.Errorf("ipv4 default originate: %w", err)
+	}
+	res.DefaultOriginateV6, err = mergeDefaultOriginate(r.DefaultOriginateV6, toMerge.DefaultOriginateV6)
+	if err != nil {
+		return frr.AllowedOut{}, fmt.Errorf("ipv6 default originate: %w", err)
+	}
+	res.ConditionalV4, err = mergeConditionalAdvertisement(r.ConditionalV4, toMerge.ConditionalV4)
+	if err != nil {
+		return frr.AllowedOut{}, fmt.Errorf("ipv4 conditional advertisement: %w", err)
+	}
+	res.ConditionalV6, err = mergeConditionalAdvertisement(r.ConditionalV6, toMerge.ConditionalV6)
+	if err != nil {
+		return frr.AllowedOut{}, fmt.Errorf("ipv6 conditional advertisement: %w", err)
+	}
 
 	localPrefForPrefix := map[string]uint32{}
 	for _, p := range r.LocalPrefPrefixesModifiers {
@@ -136,8 +281,69 @@ func mergeAllowedOut(r, toMerge frr.AllowedOut) (frr.AllowedOut, error) {
 		}
 	}
 
+	asPathPrependForPrefix := map[string]frr.ASPathPrependPrefixList{}
+	for _, p := range r.ASPathPrependPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			asPathPrependForPrefix[prefix] = p
+		}
+	}
+	for _, p := range toMerge.ASPathPrependPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			existing, ok := asPathPrependForPrefix[prefix]
+			if ok && (existing.ASN != p.ASN || existing.Repeat != p.Repeat) {
+				return frr.AllowedOut{}, fmt.Errorf("multiple as path prepends (%d x%d != %d x%d) specified for prefix %s", existing.ASN, existing.Repeat, p.ASN, p.Repeat, prefix)
+			}
+		}
+	}
+
+	medForPrefix := map[string]uint32{}
+	for _, p := range r.MEDPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			medForPrefix[prefix] = p.MED
+		}
+	}
+	for _, p := range toMerge.MEDPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			if existing, ok := medForPrefix[prefix]; ok && existing != p.MED {
+				return frr.AllowedOut{}, fmt.Errorf("multiple meds (%d != %d) specified for prefix %s", existing, p.MED, prefix)
+			}
+		}
+	}
+
+	originForPrefix := map[string]string{}
+	for _, p := range r.OriginPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			originForPrefix[prefix] = p.Origin
+		}
+	}
+	for _, p := range toMerge.OriginPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			if existing, ok := originForPrefix[prefix]; ok && existing != p.Origin {
+				return frr.AllowedOut{}, fmt.Errorf("multiple origins (%s != %s) specified for prefix %s", existing, p.Origin, prefix)
+			}
+		}
+	}
+
+	linkBandwidthForPrefix := map[string]string{}
+	for _, p := range r.LinkBandwidthPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			linkBandwidthForPrefix[prefix] = p.LinkBandwidth.String()
+		}
+	}
+	for _, p := range toMerge.LinkBandwidthPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			if existing, ok := linkBandwidthForPrefix[prefix]; ok && existing != p.LinkBandwidth.String() {
+				return frr.AllowedOut{}, fmt.Errorf("multiple link bandwidths (%s != %s) specified for prefix %s", existing, p.LinkBandwidth, prefix)
+			}
+		}
+	}
+
 	res.CommunityPrefixesModifiers = mergeCommunityPrefixLists(r.CommunityPrefixesModifiers, toMerge.CommunityPrefixesModifiers)
 	res.LocalPrefPrefixesModifiers = mergeLocalPrefPrefixLists(r.LocalPrefPrefixesModifiers, toMerge.LocalPrefPrefixesModifiers)
+	res.ASPathPrependPrefixesModifiers = mergeASPathPrependPrefixLists(r.ASPathPrependPrefixesModifiers, toMerge.ASPathPrependPrefixesModifiers)
+	res.MEDPrefixesModifiers = mergeMEDPrefixLists(r.MEDPrefixesModifiers, toMerge.MEDPrefixesModifiers)
+	res.OriginPrefixesModifiers = mergeOriginPrefixLists(r.OriginPrefixesModifiers, toMerge.OriginPrefixesModifiers)
+	res.LinkBandwidthPrefixesModifiers = mergeLinkBandwidthPrefixLists(r.LinkBandwidthPrefixesModifiers, toMerge.LinkBandwidthPrefixesModifiers)
 
 	return res, nil
 }
@@ -152,6 +358,26 @@ func mergeNextHop(curr, toMerge string) (string, error) {
 	return "", fmt.Errorf("multiple next hops (%s != %s) specified", curr, toMerge)
 }
 
+func mergeDefaultOriginate(curr, toMerge *frr.DefaultOriginate) (*frr.DefaultOriginate, error) {
+	if curr == nil {
+		return toMerge, nil
+	}
+	if toMerge == nil || *curr == *toMerge {
+		return curr, nil
+	}
+	return nil, fmt.Errorf("multiple default originate conditions (%q != %q) specified", curr.ConditionPrefix, toMerge.ConditionPrefix)
+}
+
+func mergeConditionalAdvertisement(curr, toMerge *frr.ConditionalAdvertisement) (*frr.ConditionalAdvertisement, error) {
+	if curr == nil {
+		return toMerge, nil
+	}
+	if toMerge == nil || reflect.DeepEqual(curr, toMerge) {
+		return curr, nil
+	}
+	return nil, fmt.Errorf("multiple conditional advertisements specified")
+}
+
 func mergeLocalPrefPrefixLists(curr, toMerge []frr.LocalPrefPrefixList) []frr.LocalPrefPrefixList {
 	allMap := map[string]frr.LocalPrefPrefixList{}
 	for _, prefixList := range curr {
@@ -190,21 +416,152 @@ func mergeCommunityPrefixLists(curr, toMerge []frr.CommunityPrefixList) []frr.Co
 	return sortMap(allMap)
 }
 
+func mergeASPathPrependPrefixLists(curr, toMerge []frr.ASPathPrependPrefixList) []frr.ASPathPrependPrefixList {
+	allMap := map[string]frr.ASPathPrependPrefixList{}
+	for _, prefixList := range curr {
+		allMap[asPathPrependPrefixListKey(prefixList.ASN, prefixList.Repeat, prefixList.IPFamily)] = prefixList
+	}
+	for _, prefixList := range toMerge {
+		k := asPathPrependPrefixListKey(prefixList.ASN, prefixList.Repeat, prefixList.IPFamily)
+		addTo, ok := allMap[k]
+		if !ok {
+			allMap[k] = prefixList
+			continue
+		}
+		addTo.Prefixes = addTo.Prefixes.Union(prefixList.Prefixes)
+		allMap[k] = addTo
+	}
+
+	return sortMap(allMap)
+}
+
+func mergeMEDPrefixLists(curr, toMerge []frr.MEDPrefixList) []frr.MEDPrefixList {
+	allMap := map[string]frr.MEDPrefixList{}
+	for _, prefixList := range curr {
+		allMap[medPrefixListKey(prefixList.MED, prefixList.IPFamily)] = prefixList
+	}
+	for _, prefixList := range toMerge {
+		k := medPrefixListKey(prefixList.MED, prefixList.IPFamily)
+		addTo, ok := allMap[k]
+		if !ok {
+			allMap[k] = prefixList
+			continue
+		}
+		addTo.Prefixes = addTo.Prefixes.Union(prefixList.Prefixes)
+		allMap[k] = addTo
+	}
+
+	return sortMap(allMap)
+}
+
+func mergeLinkBandwidthPrefixLists(curr, toMerge []frr.LinkBandwidthPrefixList) []frr.LinkBandwidthPrefixList {
+	allMap := map[string]frr.LinkBandwidthPrefixList{}
+	for _, prefixList := range curr {
+		allMap[linkBandwidthPrefixListKey(prefixList.LinkBandwidth, prefixList.IPFamily)] = prefixList
+	}
+	for _, prefixList := range toMerge {
+		k := linkBandwidthPrefixListKey(prefixList.LinkBandwidth, prefixList.IPFamily)
+		addTo, ok := allMap[k]
+		if !ok {
+			allMap[k] = prefixList
+			continue
+		}
+		addTo.Prefixes = addTo.Prefixes.Union(prefixList.Prefixes)
+		allMap[k] = addTo
+	}
+
+	return sortMap(allMap)
+}
+
+func mergeOriginPrefixLists(curr, toMerge []frr.OriginPrefixList) []frr.OriginPrefixList {
+	allMap := map[string]frr.OriginPrefixList{}
+	for _, prefixList := range curr {
+		allMap[originPrefixListKey(prefixList.Origin, prefixList.IPFamily)] = prefixList
+	}
+	for _, prefixList := range toMerge {
+		k := originPrefixListKey(prefixList.Origin, prefixList.IPFamily)
+		addTo, ok := allMap[k]
+		if !ok {
+			allMap[k] = prefixList
+			continue
+		}
+		addTo.Prefixes = addTo.Prefixes.Union(prefixList.Prefixes)
+		allMap[k] = addTo
+	}
+
+	return sortMap(allMap)
+}
+
+func mergeWeightPrefixLists(curr, toMerge []frr.WeightPrefixList) []frr.WeightPrefixList {
+	allMap := map[string]frr.WeightPrefixList{}
+	for _, prefixList := range curr {
+		allMap[weightPrefixListKey(prefixList.Weight, prefixList.IPFamily)] = prefixList
+	}
+	for _, prefixList := range toMerge {
+		k := weightPrefixListKey(prefixList.Weight, prefixList.IPFamily)
+		addTo, ok := allMap[k]
+		if !ok {
+			allMap[k] = prefixList
+			continue
+		}
+		addTo.Prefixes = addTo.Prefixes.Union(prefixList.Prefixes)
+		allMap[k] = addTo
+	}
+
+	return sortMap(allMap)
+}
+
 // Merges the allowed incoming prefixes, assuming they are for the same neighbor.
-func mergeAllowedIn(r, toMerge frr.AllowedIn) frr.AllowedIn {
+func mergeAllowedIn(r, toMerge frr.AllowedIn) (frr.AllowedIn, error) {
 	res := frr.AllowedIn{
 		PrefixesV4: make([]frr.IncomingFilter, 0),
 		PrefixesV6: make([]frr.IncomingFilter, 0),
 	}
+
+	localPrefForPrefix := map[string]uint32{}
+	for _, p := range r.LocalPrefPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			localPrefForPrefix[prefix] = p.LocalPref
+		}
+	}
+	for _, p := range toMerge.LocalPrefPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			if existing, ok := localPrefForPrefix[prefix]; ok && existing != p.LocalPref {
+				return frr.AllowedIn{}, fmt.Errorf("multiple local prefs (%d != %d) specified for incoming prefix %s", existing, p.LocalPref, prefix)
+			}
+		}
+	}
+
+	weightForPrefix := map[string]uint32{}
+	for _, p := range r.WeightPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			weightForPrefix[prefix] = p.Weight
+		}
+	}
+	for _, p := range toMerge.WeightPrefixesModifiers {
+		for _, prefix := range p.Prefixes.UnsortedList() {
+			if existing, ok := weightForPrefix[prefix]; ok && existing != p.Weight {
+				return frr.AllowedIn{}, fmt.Errorf("multiple weights (%d != %d) specified for incoming prefix %s", existing, p.Weight, prefix)
+			}
+		}
+	}
+
+	res.LocalPrefPrefixesModifiers = mergeLocalPrefPrefixLists(r.LocalPrefPrefixesModifiers, toMerge.LocalPrefPrefixesModifiers)
+	res.CommunityPrefixesModifiers = mergeCommunityPrefixLists(r.CommunityPrefixesModifiers, toMerge.CommunityPrefixesModifiers)
+	res.WeightPrefixesModifiers = mergeWeightPrefixLists(r.WeightPrefixesModifiers, toMerge.WeightPrefixesModifiers)
+
 	if r.All || toMerge.All {
 		res.All = true
-		return res
+		return res, nil
 	}
 
 	res.PrefixesV4 = mergeIncomingFilters(r.PrefixesV4, toMerge.PrefixesV4)
 	res.PrefixesV6 = mergeIncomingFilters(r.PrefixesV6, toMerge.PrefixesV6)
+	res.Communities = sets.List(sets.New(append(r.Communities, toMerge.Communities...)...))
+	res.LargeCommunities = sets.List(sets.New(append(r.LargeCommunities, toMerge.LargeCommunities...)...))
+	res.ASPaths = sets.List(sets.New(append(r.ASPaths, toMerge.ASPaths...)...))
 
-	return res
+	return res, nil
 }
 
 // cleanNeighborDefaults unset any field whose value that is equal to the default
@@ -257,6 +614,30 @@ func routersAreCompatible(r, toMerge *frr.RouterConfig) error {
 		return fmt.Errorf("different router ids (%s != %s) specified for same vrf: %s", r.RouterID, toMerge.RouterID, r.VRF)
 	}
 
+	if r.ClusterID != "" && toMerge.ClusterID != "" && r.ClusterID != toMerge.ClusterID {
+		return fmt.Errorf("different cluster ids (%s != %s) specified for same vrf: %s", r.ClusterID, toMerge.ClusterID, r.VRF)
+	}
+
+	if r.BestPath != nil && toMerge.BestPath != nil && !reflect.DeepEqual(r.BestPath, toMerge.BestPath) {
+		return fmt.Errorf("different best path settings specified for same vrf: %s", r.VRF)
+	}
+
+	if r.ListenLimit != nil && toMerge.ListenLimit != nil && *r.ListenLimit != *toMerge.ListenLimit {
+		return fmt.Errorf("different listen limits (%d != %d) specified for same vrf: %s", *r.ListenLimit, *toMerge.ListenLimit, r.VRF)
+	}
+
+	if r.StartupProtection != nil && toMerge.StartupProtection != nil && !reflect.DeepEqual(r.StartupProtection, toMerge.StartupProtection) {
+		return fmt.Errorf("different startup protection settings specified for same vrf: %s", r.VRF)
+	}
+
+	if r.GracefulRestart != nil && toMerge.GracefulRestart != nil && !reflect.DeepEqual(r.GracefulRestart, toMerge.GracefulRestart) {
+		return fmt.Errorf("different graceful restart settings specified for same vrf: %s", r.VRF)
+	}
+
+	if r.Confederation != nil && toMerge.Confederation != nil && !reflect.DeepEqual(r.Confederation, toMerge.Confederation) {
+		return fmt.Errorf("different confederation settings specified for same vrf: %s", r.VRF)
+	}
+
 	return nil
 }
 
@@ -289,10 +670,38 @@ func neighborsAreCompatible(n1, n2 *frr.NeighborConfig) error {
 		return fmt.Errorf("multiple bfd profiles specified for %s", neighborKey)
 	}
 
+	if (n1.TTLSecurityHops != nil && n2.EBGPMultiHop) || (n2.TTLSecurityHops != nil && n1.EBGPMultiHop) {
+		return fmt.Errorf("ttl security and ebgp-multihop both specified for %s", neighborKey)
+	}
+
+	if !reflect.DeepEqual(n1.TTLSecurityHops, n2.TTLSecurityHops) {
+		return fmt.Errorf("multiple ttl security hops specified for %s", neighborKey)
+	}
+
+	if n1.Passive != n2.Passive {
+		return fmt.Errorf("conflicting passive specified for %s", neighborKey)
+	}
+
+	if n1.ExtendedNextHop != n2.ExtendedNextHop {
+		return fmt.Errorf("conflicting extended next hop specified for %s", neighborKey)
+	}
+
 	if n1.EBGPMultiHop != n2.EBGPMultiHop {
 		return fmt.Errorf("conflicting ebgp-multihop specified for %s", neighborKey)
 	}
 
+	if n1.GracefulRestart != n2.GracefulRestart || n1.GracefulRestartMode != n2.GracefulRestartMode {
+		return fmt.Errorf("conflicting graceful restart specified for %s", neighborKey)
+	}
+
+	if n1.Description != "" && n2.Description != "" && n1.Description != n2.Description {
+		return fmt.Errorf("multiple descriptions specified for %s", neighborKey)
+	}
+
+	if n1.ShutdownMessage != "" && n2.ShutdownMessage != "" && n1.ShutdownMessage != n2.ShutdownMessage {
+		return fmt.Errorf("multiple shutdown messages specified for %s", neighborKey)
+	}
+
 	if n1.IPFamily != n2.IPFamily {
 		return fmt.Errorf("conflicting advertiseDualStack specified for %s", neighborKey)
 	}
@@ -309,10 +718,31 @@ func neighborsAreCompatible(n1, n2 *frr.NeighborConfig) error {
 		return fmt.Errorf("multiple connect times specified for %s", neighborKey)
 	}
 
+	if n1.AddPathTX != n2.AddPathTX || n1.AddPathRXDisabled != n2.AddPathRXDisabled {
+		return fmt.Errorf("multiple add path settings specified for %s", neighborKey)
+	}
+
+	if n1.AllowASIn != n2.AllowASIn {
+		return fmt.Errorf("multiple allowas-in settings specified for %s", neighborKey)
+	}
+
+	if n1.ASOverride != n2.ASOverride {
+		return fmt.Errorf("conflicting as-override specified for %s", neighborKey)
+	}
+
+	if !reflect.DeepEqual(n1.RemovePrivateAS, n2.RemovePrivateAS) {
+		return fmt.Errorf("multiple remove-private-AS settings specified for %s", neighborKey)
+	}
+
 	if n1.LocalASN != n2.LocalASN {
 		return fmt.Errorf("multiple localASNs specified for %s", neighborKey)
 	}
 
+	// Configurations are compatible if at least one of the max prefixes is unset, or if they match.
+	if n1.MaxPrefixes != nil && n2.MaxPrefixes != nil && !reflect.DeepEqual(n1.MaxPrefixes, n2.MaxPrefixes) {
+		return fmt.Errorf("multiple max prefixes specified for %s", neighborKey)
+	}
+
 	return nil
 }
 
@@ -401,6 +401,182 @@ func TestMergeRouters(t *testing.T) {
 			},
 			err: fmt.Errorf("different router ids (%s != %s) specified for same vrf: %s", "192.0.2.1", "192.0.2.20", ""),
 		},
+		{
+			name: "Same VRF+ASN, best path from one config",
+			curr: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				BestPath: &frr.BestPathConfig{
+					MaximumPathsEBGP:     ptr.To[uint32](8),
+					ASPathMultipathRelax: true,
+				},
+			},
+			toMerge: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+			},
+			expected: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				BestPath: &frr.BestPathConfig{
+					MaximumPathsEBGP:     ptr.To[uint32](8),
+					ASPathMultipathRelax: true,
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Same VRF+ASN, different best path settings",
+			curr: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				BestPath: &frr.BestPathConfig{
+					MaximumPathsEBGP: ptr.To[uint32](8),
+				},
+			},
+			toMerge: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				BestPath: &frr.BestPathConfig{
+					MaximumPathsEBGP: ptr.To[uint32](4),
+				},
+			},
+			err: fmt.Errorf("different best path settings specified for same vrf: %s", ""),
+		},
+		{
+			name: "Same VRF+ASN, different graceful restart settings",
+			curr: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				GracefulRestart: &frr.GracefulRestartConfig{
+					RestartTime: ptr.To[int64](60),
+				},
+			},
+			toMerge: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				GracefulRestart: &frr.GracefulRestartConfig{
+					LongLivedStaleTime: ptr.To[int64](3600),
+				},
+			},
+			err: fmt.Errorf("different graceful restart settings specified for same vrf: %s", ""),
+		},
+		{
+			name: "Same VRF+ASN, different confederation settings",
+			curr: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				Confederation: &frr.ConfederationConfig{
+					Identifier: 64512,
+					Peers:      []uint32{65002},
+				},
+			},
+			toMerge: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				Confederation: &frr.ConfederationConfig{
+					Identifier: 64512,
+					Peers:      []uint32{65003},
+				},
+			},
+			err: fmt.Errorf("different confederation settings specified for same vrf: %s", ""),
+		},
+		{
+			name: "Same VRF+ASN, aggregates from both configs",
+			curr: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{"192.0.2.1/32"},
+				IPV6Prefixes: []string{},
+				IPV4Aggregates: []frr.AggregateConfig{
+					{Prefix: "192.0.2.0/24", SummaryOnly: true},
+				},
+			},
+			toMerge: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{"192.0.2.2/32"},
+				IPV6Prefixes: []string{},
+				IPV4Aggregates: []frr.AggregateConfig{
+					{Prefix: "192.0.2.0/24", SummaryOnly: true},
+					{Prefix: "192.0.0.0/16", ASSet: true},
+				},
+			},
+			expected: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{"192.0.2.1/32", "192.0.2.2/32"},
+				IPV6Prefixes: []string{},
+				IPV4Aggregates: []frr.AggregateConfig{
+					{Prefix: "192.0.0.0/16", ASSet: true},
+					{Prefix: "192.0.2.0/24", SummaryOnly: true},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "Same VRF+ASN, different settings for the same redistributed source",
+			curr: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				Redistribute: []frr.RedistributeConfig{
+					{Source: "connected", Metric: ptr.To[uint32](10)},
+				},
+			},
+			toMerge: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				Redistribute: []frr.RedistributeConfig{
+					{Source: "connected"},
+					{Source: "static"},
+				},
+			},
+			err: fmt.Errorf("different redistribute settings specified for source %s for same vrf: %s", "connected", ""),
+		},
+		{
+			name: "Same VRF+ASN, different settings for the same aggregate",
+			curr: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				IPV6Aggregates: []frr.AggregateConfig{
+					{Prefix: "2001:db8::/32", SummaryOnly: true},
+				},
+			},
+			toMerge: &frr.RouterConfig{
+				MyASN:        65001,
+				VRF:          "",
+				IPV4Prefixes: []string{},
+				IPV6Prefixes: []string{},
+				IPV6Aggregates: []frr.AggregateConfig{
+					{Prefix: "2001:db8::/32"},
+				},
+			},
+			err: fmt.Errorf("different aggregate settings specified for prefix %s for same vrf: %s", "2001:db8::/32", ""),
+		},
 	}
 
 	for _, test := range tests {
@@ -1023,7 +1199,7 @@ func TestMergeNeighbors(t *testing.T) {
 			err: fmt.Errorf("multiple local prefs specified for prefix %s", "192.0.2.0/24"),
 		},
 		{
-			name: "Multiple next hops for a prefix family",
+			name: "Multiple meds for a prefix",
 			curr: []*frr.NeighborConfig{
 				{
 					IPFamily: ipfamily.IPv4,
@@ -1032,7 +1208,9 @@ func TestMergeNeighbors(t *testing.T) {
 					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
 						PrefixesV4: []string{"192.0.2.0/24"},
-						NextHopV4:  "192.0.2.1",
+						MEDPrefixesModifiers: []frr.MEDPrefixList{
+							medPrefixListFor("65040@192.0.1.20", 100, "ip", []string{"192.0.2.0/24"}),
+						},
 					},
 				},
 			},
@@ -1044,14 +1222,16 @@ func TestMergeNeighbors(t *testing.T) {
 					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
 						PrefixesV4: []string{"192.0.2.0/24"},
-						NextHopV4:  "192.0.2.2",
+						MEDPrefixesModifiers: []frr.MEDPrefixList{
+							medPrefixListFor("65040@192.0.1.20", 150, "ip", []string{"192.0.2.0/24"}),
+						},
 					},
 				},
 			},
-			err: fmt.Errorf("ipv4 next hop: multiple next hops (192.0.2.1 != 192.0.2.2) specified"),
+			err: fmt.Errorf("multiple meds specified for prefix %s", "192.0.2.0/24"),
 		},
 		{
-			name: "Multiple ipv6 next hops for a prefix family",
+			name: "Multiple link bandwidths for a prefix",
 			curr: []*frr.NeighborConfig{
 				{
 					IPFamily: ipfamily.IPv4,
@@ -1059,8 +1239,10 @@ func TestMergeNeighbors(t *testing.T) {
 					ASN:      "65040",
 					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
-						PrefixesV6: []string{"2001:db8::/64"},
-						NextHopV6:  "2001:db8::1",
+						PrefixesV4: []string{"192.0.2.0/24"},
+						LinkBandwidthPrefixesModifiers: []frr.LinkBandwidthPrefixList{
+							linkBandwidthPrefixListFor("65040@192.0.1.20", ptr.To[uint32](100), "ip", []string{"192.0.2.0/24"}),
+						},
 					},
 				},
 			},
@@ -1071,15 +1253,17 @@ func TestMergeNeighbors(t *testing.T) {
 					ASN:      "65040",
 					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
-						PrefixesV6: []string{"2001:db8::/64"},
-						NextHopV6:  "2001:db8::2",
+						PrefixesV4: []string{"192.0.2.0/24"},
+						LinkBandwidthPrefixesModifiers: []frr.LinkBandwidthPrefixList{
+							linkBandwidthPrefixListFor("65040@192.0.1.20", nil, "ip", []string{"192.0.2.0/24"}),
+						},
 					},
 				},
 			},
-			err: fmt.Errorf("ipv6 next hop: multiple next hops (2001:db8::1 != 2001:db8::2) specified"),
+			err: fmt.Errorf("multiple link bandwidths specified for prefix %s", "192.0.2.0/24"),
 		},
 		{
-			name: "Next hop merged from one config",
+			name: "Multiple as path prepends for a prefix",
 			curr: []*frr.NeighborConfig{
 				{
 					IPFamily: ipfamily.IPv4,
@@ -1088,7 +1272,9 @@ func TestMergeNeighbors(t *testing.T) {
 					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
 						PrefixesV4: []string{"192.0.2.0/24"},
-						PrefixesV6: []string{"2001:db8::/64"},
+						ASPathPrependPrefixesModifiers: []frr.ASPathPrependPrefixList{
+							asPathPrependPrefixListFor("65040@192.0.1.20", 65000, 1, "ip", []string{"192.0.2.0/24"}),
+						},
 					},
 				},
 			},
@@ -1099,12 +1285,18 @@ func TestMergeNeighbors(t *testing.T) {
 					ASN:      "65040",
 					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
-						NextHopV4: "192.0.2.1",
-						NextHopV6: "2001:db8::1",
+						PrefixesV4: []string{"192.0.2.0/24"},
+						ASPathPrependPrefixesModifiers: []frr.ASPathPrependPrefixList{
+							asPathPrependPrefixListFor("65040@192.0.1.20", 65000, 2, "ip", []string{"192.0.2.0/24"}),
+						},
 					},
 				},
 			},
-			expected: []*frr.NeighborConfig{
+			err: fmt.Errorf("multiple as path prepends specified for prefix %s", "192.0.2.0/24"),
+		},
+		{
+			name: "Same origin for a prefix from two configs",
+			curr: []*frr.NeighborConfig{
 				{
 					IPFamily: ipfamily.IPv4,
 					Name:     "65040@192.0.1.20",
@@ -1112,33 +1304,24 @@ func TestMergeNeighbors(t *testing.T) {
 					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
 						PrefixesV4: []string{"192.0.2.0/24"},
-						PrefixesV6: []string{"2001:db8::/64"},
-						NextHopV4:  "192.0.2.1",
-						NextHopV6:  "2001:db8::1",
+						OriginPrefixesModifiers: []frr.OriginPrefixList{
+							originPrefixListFor("65040@192.0.1.20", "igp", "ip", []string{"192.0.2.0/24"}),
+						},
 					},
 				},
 			},
-			err: nil,
-		},
-		{
-			name: "HoldTime / KeepAlive time, one nil, the other specifies the default",
-			curr: []*frr.NeighborConfig{
+			toMerge: []*frr.NeighborConfig{
 				{
 					IPFamily: ipfamily.IPv4,
 					Name:     "65040@192.0.1.20",
 					ASN:      "65040",
 					Addr:     "192.0.1.20",
-				},
-			},
-			toMerge: []*frr.NeighborConfig{
-				{
-					IPFamily:      ipfamily.IPv4,
-					Name:          "65040@192.0.1.20",
-					ASN:           "65040",
-					Addr:          "192.0.1.20",
-					HoldTime:      ptr.To(int64(180)),
-					KeepaliveTime: ptr.To(int64(60)),
-					ConnectTime:   ptr.To(int64(60)),
+					Outgoing: frr.AllowedOut{
+						PrefixesV4: []string{"192.0.2.0/24", "192.0.3.0/24"},
+						OriginPrefixesModifiers: []frr.OriginPrefixList{
+							originPrefixListFor("65040@192.0.1.20", "igp", "ip", []string{"192.0.2.0/24", "192.0.3.0/24"}),
+						},
+					},
 				},
 			},
 			expected: []*frr.NeighborConfig{
@@ -1148,279 +1331,932 @@ func TestMergeNeighbors(t *testing.T) {
 					ASN:      "65040",
 					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
-						PrefixesV4:                 []string{},
-						PrefixesV6:                 []string{},
-						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
-						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+						PrefixesV4: []string{"192.0.2.0/24", "192.0.3.0/24"},
+						OriginPrefixesModifiers: []frr.OriginPrefixList{
+							originPrefixListFor("65040@192.0.1.20", "igp", "ip", []string{"192.0.2.0/24", "192.0.3.0/24"}),
+						},
 					},
 					Incoming: frr.AllowedIn{
-						All:        false,
 						PrefixesV4: []frr.IncomingFilter{},
 						PrefixesV6: []frr.IncomingFilter{},
 					},
 				},
 			},
-			err: nil,
 		},
 		{
-			name: "AddressFamilies: merge unicast and evpn",
+			name: "Incoming weights from two configs, one allowing all",
 			curr: []*frr.NeighborConfig{
 				{
-					IPFamily:        ipfamily.IPv4,
-					Name:            "65040@192.0.1.20",
-					ASN:             "65040",
-					Addr:            "192.0.1.20",
-					AddressFamilies: []string{"unicast"},
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Incoming: frr.AllowedIn{
+						All: true,
+						WeightPrefixesModifiers: []frr.WeightPrefixList{
+							incomingWeightPrefixListFor("65040@192.0.1.20", 100, "ip", []string{"192.0.2.0/24 le 32"}),
+						},
+					},
 				},
 			},
 			toMerge: []*frr.NeighborConfig{
 				{
-					IPFamily:        ipfamily.IPv4,
-					Name:            "65040@192.0.1.20",
-					ASN:             "65040",
-					Addr:            "192.0.1.20",
-					AddressFamilies: []string{"evpn"},
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Incoming: frr.AllowedIn{
+						PrefixesV4: []frr.IncomingFilter{
+							{IPFamily: "ipv4", Prefix: "192.0.3.0/24"},
+						},
+						WeightPrefixesModifiers: []frr.WeightPrefixList{
+							incomingWeightPrefixListFor("65040@192.0.1.20", 100, "ip", []string{"192.0.3.0/24"}),
+						},
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{
+							incomingLocalPrefPrefixListFor("65040@192.0.1.20", 200, "ip", []string{"192.0.3.0/24"}),
+						},
+					},
 				},
 			},
 			expected: []*frr.NeighborConfig{
 				{
-					IPFamily:        ipfamily.IPv4,
-					Name:            "65040@192.0.1.20",
-					ASN:             "65040",
-					Addr:            "192.0.1.20",
-					AddressFamilies: []string{"evpn", "unicast"},
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
-						PrefixesV4:                 []string{},
-						PrefixesV6:                 []string{},
-						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
-						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+						PrefixesV4: []string{},
+						PrefixesV6: []string{},
 					},
 					Incoming: frr.AllowedIn{
-						All:        false,
+						All:        true,
 						PrefixesV4: []frr.IncomingFilter{},
 						PrefixesV6: []frr.IncomingFilter{},
+						WeightPrefixesModifiers: []frr.WeightPrefixList{
+							incomingWeightPrefixListFor("65040@192.0.1.20", 100, "ip", []string{"192.0.2.0/24 le 32", "192.0.3.0/24"}),
+						},
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{
+							incomingLocalPrefPrefixListFor("65040@192.0.1.20", 200, "ip", []string{"192.0.3.0/24"}),
+						},
 					},
 				},
 			},
-			err: nil,
 		},
 		{
-			name: "AddressFamilies: both have same families, no duplicates",
+			name: "Incoming communities and as paths from two configs",
 			curr: []*frr.NeighborConfig{
 				{
-					IPFamily:        ipfamily.IPv4,
-					Name:            "65040@192.0.1.20",
-					ASN:             "65040",
-					Addr:            "192.0.1.20",
-					AddressFamilies: []string{"unicast", "evpn"},
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Incoming: frr.AllowedIn{
+						Communities: []string{"10:100"},
+						ASPaths:     []string{"^65040_"},
+					},
 				},
 			},
 			toMerge: []*frr.NeighborConfig{
 				{
-					IPFamily:        ipfamily.IPv4,
-					Name:            "65040@192.0.1.20",
-					ASN:             "65040",
-					Addr:            "192.0.1.20",
-					AddressFamilies: []string{"unicast", "evpn"},
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Incoming: frr.AllowedIn{
+						Communities:      []string{"10:100", "10:50"},
+						LargeCommunities: []string{"123:456:7890"},
+					},
 				},
 			},
 			expected: []*frr.NeighborConfig{
 				{
-					IPFamily:        ipfamily.IPv4,
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4: []string{},
+						PrefixesV6: []string{},
+					},
+					Incoming: frr.AllowedIn{
+						PrefixesV4:       []frr.IncomingFilter{},
+						PrefixesV6:       []frr.IncomingFilter{},
+						Communities:      []string{"10:100", "10:50"},
+						LargeCommunities: []string{"123:456:7890"},
+						ASPaths:          []string{"^65040_"},
+					},
+				},
+			},
+		},
+		{
+			name: "Multiple incoming local prefs for a prefix",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Incoming: frr.AllowedIn{
+						All: true,
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{
+							incomingLocalPrefPrefixListFor("65040@192.0.1.20", 100, "ip", []string{"192.0.2.0/24"}),
+						},
+					},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Incoming: frr.AllowedIn{
+						All: true,
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{
+							incomingLocalPrefPrefixListFor("65040@192.0.1.20", 150, "ip", []string{"192.0.2.0/24"}),
+						},
+					},
+				},
+			},
+			err: fmt.Errorf("multiple local prefs specified for incoming prefix %s", "192.0.2.0/24"),
+		},
+		{
+			name: "Multiple next hops for a prefix family",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4: []string{"192.0.2.0/24"},
+						NextHopV4:  "192.0.2.1",
+					},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4: []string{"192.0.2.0/24"},
+						NextHopV4:  "192.0.2.2",
+					},
+				},
+			},
+			err: fmt.Errorf("ipv4 next hop: multiple next hops (192.0.2.1 != 192.0.2.2) specified"),
+		},
+		{
+			name: "Multiple ipv6 next hops for a prefix family",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						PrefixesV6: []string{"2001:db8::/64"},
+						NextHopV6:  "2001:db8::1",
+					},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						PrefixesV6: []string{"2001:db8::/64"},
+						NextHopV6:  "2001:db8::2",
+					},
+				},
+			},
+			err: fmt.Errorf("ipv6 next hop: multiple next hops (2001:db8::1 != 2001:db8::2) specified"),
+		},
+		{
+			name: "Next hop merged from one config",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4: []string{"192.0.2.0/24"},
+						PrefixesV6: []string{"2001:db8::/64"},
+					},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						NextHopV4: "192.0.2.1",
+						NextHopV6: "2001:db8::1",
+					},
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4: []string{"192.0.2.0/24"},
+						PrefixesV6: []string{"2001:db8::/64"},
+						NextHopV4:  "192.0.2.1",
+						NextHopV6:  "2001:db8::1",
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "HoldTime / KeepAlive time, one nil, the other specifies the default",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:      ipfamily.IPv4,
+					Name:          "65040@192.0.1.20",
+					ASN:           "65040",
+					Addr:          "192.0.1.20",
+					HoldTime:      ptr.To(int64(180)),
+					KeepaliveTime: ptr.To(int64(60)),
+					ConnectTime:   ptr.To(int64(60)),
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4:                 []string{},
+						PrefixesV6:                 []string{},
+						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+					},
+					Incoming: frr.AllowedIn{
+						All:        false,
+						PrefixesV4: []frr.IncomingFilter{},
+						PrefixesV6: []frr.IncomingFilter{},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "AddressFamilies: merge unicast and evpn",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65040@192.0.1.20",
+					ASN:             "65040",
+					Addr:            "192.0.1.20",
+					AddressFamilies: []string{"unicast"},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65040@192.0.1.20",
+					ASN:             "65040",
+					Addr:            "192.0.1.20",
+					AddressFamilies: []string{"evpn"},
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65040@192.0.1.20",
+					ASN:             "65040",
+					Addr:            "192.0.1.20",
+					AddressFamilies: []string{"evpn", "unicast"},
+					Outgoing: frr.AllowedOut{
+						PrefixesV4:                 []string{},
+						PrefixesV6:                 []string{},
+						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+					},
+					Incoming: frr.AllowedIn{
+						All:        false,
+						PrefixesV4: []frr.IncomingFilter{},
+						PrefixesV6: []frr.IncomingFilter{},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "AddressFamilies: both have same families, no duplicates",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65040@192.0.1.20",
+					ASN:             "65040",
+					Addr:            "192.0.1.20",
+					AddressFamilies: []string{"unicast", "evpn"},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65040@192.0.1.20",
+					ASN:             "65040",
+					Addr:            "192.0.1.20",
+					AddressFamilies: []string{"unicast", "evpn"},
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65040@192.0.1.20",
+					ASN:             "65040",
+					Addr:            "192.0.1.20",
+					AddressFamilies: []string{"evpn", "unicast"},
+					Outgoing: frr.AllowedOut{
+						PrefixesV4:                 []string{},
+						PrefixesV6:                 []string{},
+						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+					},
+					Incoming: frr.AllowedIn{
+						All:        false,
+						PrefixesV4: []frr.IncomingFilter{},
+						PrefixesV6: []frr.IncomingFilter{},
+					},
+				},
+			},
+			err: nil,
+		},
+		{
+			// This test is similar to the previous, to ensure conversion stability
+			name: "HoldTime / KeepAlive time, one set to the default, the other set to nil",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily:      ipfamily.IPv4,
+					Name:          "65040@192.0.1.20",
+					ASN:           "65040",
+					Addr:          "192.0.1.20",
+					HoldTime:      ptr.To(int64(180)),
+					KeepaliveTime: ptr.To(int64(60)),
+					ConnectTime:   ptr.To(int64(60)),
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "BFDProfile, one empty, the other specifies a value",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value",
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4:                 []string{},
+						PrefixesV6:                 []string{},
+						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+					},
+					Incoming: frr.AllowedIn{
+						All:        false,
+						PrefixesV4: []frr.IncomingFilter{},
+						PrefixesV6: []frr.IncomingFilter{},
+					},
+				},
+			},
+		},
+		{
+			name: "BFDProfile, one specifies the value, the other is empty",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value",
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "",
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4:                 []string{},
+						PrefixesV6:                 []string{},
+						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+					},
+					Incoming: frr.AllowedIn{
+						All:        false,
+						PrefixesV4: []frr.IncomingFilter{},
+						PrefixesV6: []frr.IncomingFilter{},
+					},
+				},
+			},
+		},
+		{
+			name: "BFDProfile, both specify the same value",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value",
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value",
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value",
+					Outgoing: frr.AllowedOut{
+						PrefixesV4:                 []string{},
+						PrefixesV6:                 []string{},
+						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
+						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+					},
+					Incoming: frr.AllowedIn{
+						All:        false,
+						PrefixesV4: []frr.IncomingFilter{},
+						PrefixesV6: []frr.IncomingFilter{},
+					},
+				},
+			},
+		},
+		{
+			name: "BFDProfile, both specify different values",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value1",
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:   ipfamily.IPv4,
+					Name:       "65040@192.0.1.20",
+					ASN:        "65040",
+					Addr:       "192.0.1.20",
+					BFDProfile: "value2",
+				},
+			},
+			err: fmt.Errorf("got multiple bfd profiles specified for %s", "192.0.2.0"),
+		},
+		{
+			name: "MaxPrefixes, one specifies the value, the other is empty",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					MaxPrefixes: &frr.MaxPrefixes{
+						IPv4:        ptr.To[uint32](100),
+						WarningOnly: true,
+					},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					MaxPrefixes: &frr.MaxPrefixes{
+						IPv4:        ptr.To[uint32](100),
+						WarningOnly: true,
+					},
+					Outgoing: frr.AllowedOut{
+						PrefixesV4: []string{},
+						PrefixesV6: []string{},
+					},
+					Incoming: frr.AllowedIn{
+						PrefixesV4: []frr.IncomingFilter{},
+						PrefixesV6: []frr.IncomingFilter{},
+					},
+				},
+			},
+		},
+		{
+			name: "MaxPrefixes, both specify different values",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					MaxPrefixes: &frr.MaxPrefixes{
+						IPv4: ptr.To[uint32](100),
+					},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					MaxPrefixes: &frr.MaxPrefixes{
+						IPv4: ptr.To[uint32](200),
+					},
+				},
+			},
+			err: fmt.Errorf("multiple max prefixes specified for %s", "192.0.1.20"),
+		},
+		{
+			name: "RouteReflectorClient, only one specifies it",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily:             ipfamily.IPv4,
+					Name:                 "65040@192.0.1.20",
+					ASN:                  "65040",
+					Addr:                 "192.0.1.20",
+					RouteReflectorClient: true,
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily:             ipfamily.IPv4,
+					Name:                 "65040@192.0.1.20",
+					ASN:                  "65040",
+					Addr:                 "192.0.1.20",
+					RouteReflectorClient: true,
+					Outgoing:             frr.AllowedOut{},
+					Incoming:             frr.AllowedIn{},
+				},
+			},
+		},
+		{
+			name: "AddPath, both specify different values",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily:  ipfamily.IPv4,
+					Name:      "65040@192.0.1.20",
+					ASN:       "65040",
+					Addr:      "192.0.1.20",
+					AddPathTX: "all",
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:  ipfamily.IPv4,
+					Name:      "65040@192.0.1.20",
+					ASN:       "65040",
+					Addr:      "192.0.1.20",
+					AddPathTX: "bestpath-per-as",
+				},
+			},
+			err: fmt.Errorf("multiple add path settings specified for %s", "192.0.1.20"),
+		},
+		{
+			name: "Shutdown and description, only one specifies them",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:        ipfamily.IPv4,
 					Name:            "65040@192.0.1.20",
 					ASN:             "65040",
 					Addr:            "192.0.1.20",
-					AddressFamilies: []string{"evpn", "unicast"},
-					Outgoing: frr.AllowedOut{
-						PrefixesV4:                 []string{},
-						PrefixesV6:                 []string{},
-						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
-						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
-					},
-					Incoming: frr.AllowedIn{
-						All:        false,
-						PrefixesV4: []frr.IncomingFilter{},
-						PrefixesV6: []frr.IncomingFilter{},
-					},
+					Description:     "tor",
+					Shutdown:        true,
+					ShutdownMessage: "maintenance",
+				},
+			},
+			expected: []*frr.NeighborConfig{
+				{
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65040@192.0.1.20",
+					ASN:             "65040",
+					Addr:            "192.0.1.20",
+					Description:     "tor",
+					Shutdown:        true,
+					ShutdownMessage: "maintenance",
 				},
 			},
-			err: nil,
 		},
 		{
-			// This test is similar to the previous, to ensure conversion stability
-			name: "HoldTime / KeepAlive time, one set to the default, the other set to nil",
+			name: "Description, both specify different values",
 			curr: []*frr.NeighborConfig{
 				{
-					IPFamily:      ipfamily.IPv4,
-					Name:          "65040@192.0.1.20",
-					ASN:           "65040",
-					Addr:          "192.0.1.20",
-					HoldTime:      ptr.To(int64(180)),
-					KeepaliveTime: ptr.To(int64(60)),
-					ConnectTime:   ptr.To(int64(60)),
+					IPFamily:    ipfamily.IPv4,
+					Name:        "65040@192.0.1.20",
+					ASN:         "65040",
+					Addr:        "192.0.1.20",
+					Description: "tor A",
 				},
 			},
 			toMerge: []*frr.NeighborConfig{
 				{
-					IPFamily: ipfamily.IPv4,
-					Name:     "65040@192.0.1.20",
-					ASN:      "65040",
-					Addr:     "192.0.1.20",
+					IPFamily:    ipfamily.IPv4,
+					Name:        "65040@192.0.1.20",
+					ASN:         "65040",
+					Addr:        "192.0.1.20",
+					Description: "tor B",
 				},
 			},
-			expected: []*frr.NeighborConfig{
+			err: fmt.Errorf("multiple descriptions specified for %s", "192.0.1.20"),
+		},
+		{
+			name: "AllowASIn, both specify different values",
+			curr: []*frr.NeighborConfig{
 				{
-					IPFamily: ipfamily.IPv4,
-					Name:     "65040@192.0.1.20",
-					ASN:      "65040",
-					Addr:     "192.0.1.20",
+					IPFamily:  ipfamily.IPv4,
+					Name:      "65040@192.0.1.20",
+					ASN:       "65040",
+					Addr:      "192.0.1.20",
+					AllowASIn: "3",
 				},
 			},
-			err: nil,
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:  ipfamily.IPv4,
+					Name:      "65040@192.0.1.20",
+					ASN:       "65040",
+					Addr:      "192.0.1.20",
+					AllowASIn: "origin",
+				},
+			},
+			err: fmt.Errorf("multiple allowas-in settings specified for %s", "192.0.1.20"),
 		},
 		{
-			name: "BFDProfile, one empty, the other specifies a value",
+			name: "RemovePrivateAS, only one specifies it",
 			curr: []*frr.NeighborConfig{
 				{
 					IPFamily: ipfamily.IPv4,
-					Name:     "65040@192.0.1.20",
-					ASN:      "65040",
+					Name:     "65041@192.0.1.20",
+					ASN:      "65041",
 					Addr:     "192.0.1.20",
 				},
 			},
 			toMerge: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value",
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65041@192.0.1.20",
+					ASN:             "65041",
+					Addr:            "192.0.1.20",
+					RemovePrivateAS: &frr.RemovePrivateAS{All: true},
 				},
 			},
-			expected: []*frr.NeighborConfig{
+			err: fmt.Errorf("multiple remove-private-AS settings specified for %s", "192.0.1.20"),
+		},
+		{
+			name: "TTL security on one, ebgp multihop on the other",
+			curr: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value",
-					Outgoing: frr.AllowedOut{
-						PrefixesV4:                 []string{},
-						PrefixesV6:                 []string{},
-						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
-						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
-					},
-					Incoming: frr.AllowedIn{
-						All:        false,
-						PrefixesV4: []frr.IncomingFilter{},
-						PrefixesV6: []frr.IncomingFilter{},
-					},
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65041@192.0.1.20",
+					ASN:             "65041",
+					Addr:            "192.0.1.20",
+					TTLSecurityHops: ptr.To[uint32](1),
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:     ipfamily.IPv4,
+					Name:         "65041@192.0.1.20",
+					ASN:          "65041",
+					Addr:         "192.0.1.20",
+					EBGPMultiHop: true,
 				},
 			},
+			err: fmt.Errorf("ttl security and ebgp-multihop both specified for %s", "192.0.1.20"),
 		},
 		{
-			name: "BFDProfile, one specifies the value, the other is empty",
+			name: "TTL security and passive, both specify the same values",
 			curr: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value",
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65041@192.0.1.20",
+					ASN:             "65041",
+					Addr:            "192.0.1.20",
+					TTLSecurityHops: ptr.To[uint32](2),
+					Passive:         true,
 				},
 			},
 			toMerge: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "",
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65041@192.0.1.20",
+					ASN:             "65041",
+					Addr:            "192.0.1.20",
+					TTLSecurityHops: ptr.To[uint32](2),
+					Passive:         true,
 				},
 			},
 			expected: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value",
-					Outgoing: frr.AllowedOut{
-						PrefixesV4:                 []string{},
-						PrefixesV6:                 []string{},
-						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
-						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
-					},
-					Incoming: frr.AllowedIn{
-						All:        false,
-						PrefixesV4: []frr.IncomingFilter{},
-						PrefixesV6: []frr.IncomingFilter{},
-					},
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65041@192.0.1.20",
+					ASN:             "65041",
+					Addr:            "192.0.1.20",
+					TTLSecurityHops: ptr.To[uint32](2),
+					Passive:         true,
 				},
 			},
 		},
 		{
-			name: "BFDProfile, both specify the same value",
+			name: "ExtendedNextHop, only one specifies it",
 			curr: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value",
+					IPFamily:        ipfamily.DualStack,
+					Name:            "65041@2001:db8::20",
+					ASN:             "65041",
+					Addr:            "2001:db8::20",
+					ExtendedNextHop: true,
 				},
 			},
 			toMerge: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value",
+					IPFamily: ipfamily.DualStack,
+					Name:     "65041@2001:db8::20",
+					ASN:      "65041",
+					Addr:     "2001:db8::20",
 				},
 			},
-			expected: []*frr.NeighborConfig{
+			err: fmt.Errorf("conflicting extended next hop specified for %s", "2001:db8::20"),
+		},
+		{
+			name: "GracefulRestart, both specify different modes",
+			curr: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value",
+					IPFamily:        ipfamily.IPv4,
+					Name:            "65040@192.0.1.20",
+					ASN:             "65040",
+					Addr:            "192.0.1.20",
+					GracefulRestart: true,
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily:            ipfamily.IPv4,
+					Name:                "65040@192.0.1.20",
+					ASN:                 "65040",
+					Addr:                "192.0.1.20",
+					GracefulRestartMode: "helper",
+				},
+			},
+			err: fmt.Errorf("conflicting graceful restart specified for %s", "192.0.1.20"),
+		},
+		{
+			name: "DefaultOriginate, both specify different conditions",
+			curr: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
 					Outgoing: frr.AllowedOut{
-						PrefixesV4:                 []string{},
-						PrefixesV6:                 []string{},
-						CommunityPrefixesModifiers: []frr.CommunityPrefixList{},
-						LocalPrefPrefixesModifiers: []frr.LocalPrefPrefixList{},
+						DefaultOriginateV4: &frr.DefaultOriginate{ConditionPrefix: "192.0.2.0/24"},
 					},
-					Incoming: frr.AllowedIn{
-						All:        false,
-						PrefixesV4: []frr.IncomingFilter{},
-						PrefixesV6: []frr.IncomingFilter{},
+				},
+			},
+			toMerge: []*frr.NeighborConfig{
+				{
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						DefaultOriginateV4: &frr.DefaultOriginate{},
 					},
 				},
 			},
+			err: fmt.Errorf("ipv4 default originate: multiple default originate conditions (\"192.0.2.0/24\" != \"\") specified"),
 		},
 		{
-			name: "BFDProfile, both specify different values",
+			name: "Conditional advertisement, both specify different conditions",
 			curr: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value1",
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						ConditionalV4: &frr.ConditionalAdvertisement{
+							Prefixes:        []string{"192.0.4.0/24"},
+							Condition:       "exist",
+							ConditionPrefix: frr.IncomingFilter{IPFamily: ipfamily.IPv4, Prefix: "192.0.3.0/24"},
+						},
+					},
 				},
 			},
 			toMerge: []*frr.NeighborConfig{
 				{
-					IPFamily:   ipfamily.IPv4,
-					Name:       "65040@192.0.1.20",
-					ASN:        "65040",
-					Addr:       "192.0.1.20",
-					BFDProfile: "value2",
+					IPFamily: ipfamily.IPv4,
+					Name:     "65040@192.0.1.20",
+					ASN:      "65040",
+					Addr:     "192.0.1.20",
+					Outgoing: frr.AllowedOut{
+						ConditionalV4: &frr.ConditionalAdvertisement{
+							Prefixes:        []string{"192.0.4.0/24"},
+							Condition:       "non-exist",
+							ConditionPrefix: frr.IncomingFilter{IPFamily: ipfamily.IPv4, Prefix: "192.0.3.0/24"},
+						},
+					},
 				},
 			},
-			err: fmt.Errorf("got multiple bfd profiles specified for %s", "192.0.2.0"),
+			err: fmt.Errorf("ipv4 conditional advertisement: multiple conditional advertisements specified"),
 		},
 		{
 			name: "LocalASN, both specify same value",
@@ -1762,6 +2598,98 @@ func localPrefPrefixListFor(neigID string, localPref int, ipFamily string, prefi
 	}
 }
 
+func asPathPrependPrefixListFor(neigID string, asn, repeat uint32, ipFamily string, prefixes []string) frr.ASPathPrependPrefixList {
+	return frr.ASPathPrependPrefixList{
+		PrefixList: frr.PrefixList{
+			Name:     asPathPrependPrefixListName(neigID, asn, repeat, ipFamily),
+			Prefixes: sets.New(prefixes...),
+			IPFamily: ipFamily,
+		},
+		ASN:    asn,
+		Repeat: repeat,
+	}
+}
+
+func medPrefixListFor(neigID string, med uint32, ipFamily string, prefixes []string) frr.MEDPrefixList {
+	return frr.MEDPrefixList{
+		PrefixList: frr.PrefixList{
+			Name:     medPrefixListName(neigID, med, ipFamily),
+			Prefixes: sets.New(prefixes...),
+			IPFamily: ipFamily,
+		},
+		MED: med,
+	}
+}
+
+// linkBandwidthPrefixListFor returns the prefix list associated to the given
+// bandwidth, or to the number of multipaths if bandwidth is nil.
+func linkBandwidthPrefixListFor(neigID string, bandwidth *uint32, ipFamily string, prefixes []string) frr.LinkBandwidthPrefixList {
+	linkBandwidth := community.NewLinkBandwidthNumMultipaths()
+	if bandwidth != nil {
+		var err error
+		linkBandwidth, err = community.NewLinkBandwidth(*bandwidth)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return frr.LinkBandwidthPrefixList{
+		PrefixList: frr.PrefixList{
+			Name:     linkBandwidthPrefixListName(neigID, linkBandwidth, ipFamily),
+			Prefixes: sets.New(prefixes...),
+			IPFamily: ipFamily,
+		},
+		LinkBandwidth: linkBandwidth,
+	}
+}
+
+func originPrefixListFor(neigID, origin string, ipFamily string, prefixes []string) frr.OriginPrefixList {
+	return frr.OriginPrefixList{
+		PrefixList: frr.PrefixList{
+			Name:     originPrefixListName(neigID, origin, ipFamily),
+			Prefixes: sets.New(prefixes...),
+			IPFamily: ipFamily,
+		},
+		Origin: origin,
+	}
+}
+
+func incomingLocalPrefPrefixListFor(neigID string, localPref uint32, ipFamily string, prefixes []string) frr.LocalPrefPrefixList {
+	return frr.LocalPrefPrefixList{
+		PrefixList: frr.PrefixList{
+			Name:     incomingLocalPrefPrefixListName(neigID, localPref, ipFamily),
+			Prefixes: sets.New(prefixes...),
+			IPFamily: ipFamily,
+		},
+		LocalPref: localPref,
+	}
+}
+
+func incomingCommunityPrefixListFor(neigID, comm string, ipFamily string, prefixes []string) frr.CommunityPrefixList {
+	community, err := community.New(comm)
+	if err != nil {
+		panic(err)
+	}
+	return frr.CommunityPrefixList{
+		PrefixList: frr.PrefixList{
+			Name:     incomingCommunityPrefixListName(neigID, community, ipFamily),
+			Prefixes: sets.New(prefixes...),
+			IPFamily: ipFamily,
+		},
+		Community: community,
+	}
+}
+
+func incomingWeightPrefixListFor(neigID string, weight uint32, ipFamily string, prefixes []string) frr.WeightPrefixList {
+	return frr.WeightPrefixList{
+		PrefixList: frr.PrefixList{
+			Name:     incomingWeightPrefixListName(neigID, weight, ipFamily),
+			Prefixes: sets.New(prefixes...),
+			IPFamily: ipFamily,
+		},
+		Weight: weight,
+	}
+}
+
 func communityComparer(a, b community.BGPCommunity) bool {
 	if a != nil && b != nil {
 		return a.String() == b.String()
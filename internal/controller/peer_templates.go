@@ -0,0 +1,80 @@
+// SPDX-License-Identifier:Apache-2.0
+
+package controller
+
+import (
+	"fmt"
+	"reflect"
+
+	v1beta1 "github.com/metallb/frr-k8s/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
+)
+
+// defaultedNeighbor is an empty neighbor after the api server applied
+// the defaults declared in the crd. Fields equal to their defaulted
+// value are considered as not set when resolving templates.
+var defaultedNeighbor = v1beta1.Neighbor{
+	ToAdvertise: v1beta1.Advertise{
+		Allowed: v1beta1.AllowedOutPrefixes{Mode: v1beta1.AllowRestricted},
+	},
+	ToReceive: v1beta1.Receive{
+		Allowed: v1beta1.AllowedInPrefixes{Mode: v1beta1.AllowRestricted},
+	},
+	AddressFamilies: []v1beta1.AddressFamily{v1beta1.AddressFamilyUnicast},
+}
+
+// routersWithTemplates returns a copy of the given routers, where the
+// neighbors referencing a template inherit the fields they don't set.
+func routersWithTemplates(routers []v1beta1.Router, templates map[string]v1beta1.BGPPeerTemplate) ([]v1beta1.Router, error) {
+	res := make([]v1beta1.Router, 0, len(routers))
+	for _, r := range routers {
+		withTemplates := *r.DeepCopy()
+		for i, n := range withTemplates.Neighbors {
+			resolved, err := neighborWithTemplate(n, templates)
+			if err != nil {
+				return nil, err
+			}
+			withTemplates.Neighbors[i] = resolved
+		}
+		res = append(res, withTemplates)
+	}
+	return res, nil
+}
+
+// neighborWithTemplate returns the given neighbor where all the fields that are
+// not set are taken from the template it references.
+func neighborWithTemplate(n v1beta1.Neighbor, templates map[string]v1beta1.BGPPeerTemplate) (v1beta1.Neighbor, error) {
+	if n.Template == "" {
+		return n, nil
+	}
+	template, ok := templates[n.Template]
+	if !ok {
+		return v1beta1.Neighbor{}, fmt.Errorf("neighbor %s referencing non existing template %s", neighborName(n), n.Template)
+	}
+	if template.Spec.Address != "" || template.Spec.Interface != "" || template.Spec.Template != "" {
+		return v1beta1.Neighbor{}, fmt.Errorf("template %s can't set address, interface or template", template.Name)
+	}
+
+	fromTemplate := template.Spec.Neighbor.DeepCopy()
+	res := n
+	resValue := reflect.ValueOf(&res).Elem()
+	templateValue := reflect.ValueOf(fromTemplate).Elem()
+	defaultedValue := reflect.ValueOf(defaultedNeighbor)
+	for i := 0; i < resValue.NumField(); i++ {
+		field := resValue.Field(i)
+		if !fieldIsUnset(field, defaultedValue.Field(i)) {
+			continue
+		}
+		field.Set(templateValue.Field(i))
+	}
+	return res, nil
+}
+
+func fieldIsUnset(field, defaulted reflect.Value) bool {
+	if field.IsZero() {
+		return true
+	}
+	value := field.Interface()
+	return equality.Semantic.DeepEqual(value, reflect.Zero(field.Type()).Interface()) ||
+		equality.Semantic.DeepEqual(value, defaulted.Interface())
+}
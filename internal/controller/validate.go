@@ -10,7 +10,7 @@ import (
 )
 
 // TransientError is an error that happens due to interdependencies
-// between crds, such as referencing non-existing secrets.
+// between crds, such as referencing non-existing secrets or peer templates.
 // Since we don't want webhooks to make assumptions on ordering, we reset the
 // fields that could cause a transient error from configurations before validating them.
 type TransientError struct {
@@ -21,15 +21,22 @@ func (e TransientError) Error() string { return e.Message }
 
 func Validate(resources ...client.ObjectList) error {
 	clusterResources := ClusterResources{
-		FRRConfigs: make([]v1beta1.FRRConfiguration, 0),
+		FRRConfigs:    make([]v1beta1.FRRConfiguration, 0),
+		PeerTemplates: make(map[string]v1beta1.BGPPeerTemplate),
 	}
 
 	for _, list := range resources {
-		if l, ok := list.(*v1beta1.FRRConfigurationList); ok {
+		switch l := list.(type) {
+		case *v1beta1.FRRConfigurationList:
 			clusterResources.FRRConfigs = append(clusterResources.FRRConfigs, l.Items...)
+		case *v1beta1.BGPPeerTemplateList:
+			for _, t := range l.Items {
+				clusterResources.PeerTemplates[t.Name] = t
+			}
 		}
 	}
 	resetSecrets(clusterResources.FRRConfigs)
+	resetMissingTemplates(clusterResources.FRRConfigs, clusterResources.PeerTemplates)
 
 	_, err := apiToFRR(clusterResources, []net.IPNet{})
 	return err
@@ -45,3 +52,22 @@ func resetSecrets(cfgs []v1beta1.FRRConfiguration) {
 		}
 	}
 }
+
+// Resets the references to non existing templates of the given configurations
+// as they can cause a transient error.
+func resetMissingTemplates(cfgs []v1beta1.FRRConfiguration, templates map[string]v1beta1.BGPPeerTemplate) {
+	for _, cfg := range cfgs {
+		for _, r := range cfg.Spec.BGP.Routers {
+			for i := range r.Neighbors {
+				if _, ok := templates[r.Neighbors[i].Template]; !ok {
+					r.Neighbors[i].Template = ""
+				}
+			}
+			for i := range r.ListenRanges {
+				if _, ok := templates[r.ListenRanges[i].Template]; !ok {
+					r.ListenRanges[i].Template = ""
+				}
+			}
+		}
+	}
+}
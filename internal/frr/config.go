@@ -12,6 +12,7 @@ import (
 	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"syscall"
 	"text/template"
 	"time"
@@ -34,11 +35,61 @@ var (
 )
 
 type Config struct {
-	Loglevel    Level
-	Hostname    string
-	Routers     []*RouterConfig
-	BFDProfiles []BFDProfile
-	ExtraConfig string
+	Loglevel     Level
+	Hostname     string
+	Routers      []*RouterConfig
+	BFDProfiles  []BFDProfile
+	StaticRoutes []StaticRouteConfig
+	// GracefulShutdown makes all the bgp sessions advertise their routes
+	// with the GRACEFUL_SHUTDOWN community and a local preference of 0,
+	// so that the peers move the traffic away from this node.
+	GracefulShutdown bool
+	ExtraConfig      string
+}
+
+// StaticRoutesForVRF returns the static routes configured in the given vrf.
+func (c *Config) StaticRoutesForVRF(vrf string) []StaticRouteConfig {
+	res := []StaticRouteConfig{}
+	for _, r := range c.StaticRoutes {
+		if r.VRF == vrf {
+			res = append(res, r)
+		}
+	}
+	return res
+}
+
+// StaticRoutesVRFs returns the non default vrfs with static routes that
+// don't already have a vrf block rendered for the evpn l3vni.
+func (c *Config) StaticRoutesVRFs() []string {
+	withVNI := map[string]bool{}
+	for _, r := range c.Routers {
+		if r.VRF != "" && r.EVPN != nil && r.EVPN.L3VNI != nil {
+			withVNI[r.VRF] = true
+		}
+	}
+	res := []string{}
+	for _, r := range c.StaticRoutes {
+		if r.VRF == "" || withVNI[r.VRF] || slices.Contains(res, r.VRF) {
+			continue
+		}
+		res = append(res, r.VRF)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// StaticRouteConfig represents a static route. At least one between
+// NextHop and Interface is set.
+type StaticRouteConfig struct {
+	IPFamily   ipfamily.Family
+	Prefix     string
+	NextHop    string
+	Interface  string
+	Distance   *uint32
+	Tag        *uint32
+	VRF        string
+	NextHopVRF string
+	BFDProfile string
 }
 
 type reloadEvent struct {
@@ -47,14 +98,155 @@ type reloadEvent struct {
 }
 
 type RouterConfig struct {
-	MyASN        uint32
-	RouterID     string
-	Neighbors    []*NeighborConfig
-	VRF          string
-	IPV4Prefixes []string
-	IPV6Prefixes []string
-	ImportVRFs   []string
-	EVPN         *EVPNConfig
+	MyASN             uint32
+	RouterID          string
+	Neighbors         []*NeighborConfig
+	VRF               string
+	IPV4Prefixes      []string
+	IPV6Prefixes      []string
+	IPV4Imports       []ImportConfig
+	IPV6Imports       []ImportConfig
+	EVPN              *EVPNConfig
+	ListenLimit       *uint32
+	ClusterID         string
+	BestPath          *BestPathConfig
+	IPV4Aggregates    []AggregateConfig
+	IPV6Aggregates    []AggregateConfig
+	Redistribute      []RedistributeConfig
+	StartupProtection *StartupProtectionConfig
+	GracefulRestart   *GracefulRestartConfig
+	Confederation     *ConfederationConfig
+}
+
+// ConfederationConfig represents the BGP confederation a router is part of.
+// Peers are the member ASes the router has neighbors in.
+type ConfederationConfig struct {
+	Identifier uint32
+	Peers      []uint32
+}
+
+// GracefulRestartConfig represents the graceful restart timers of a router,
+// expressed in seconds.
+type GracefulRestartConfig struct {
+	RestartTime        *int64
+	StalePathTime      *int64
+	SelectDeferTime    *int64
+	LongLivedStaleTime *int64
+}
+
+// StartupProtectionConfig represents the settings delaying or discouraging
+// the advertisements of a router after startup. UpdateDelay applies to all the
+// bgp instances and is honored only on the router of the default vrf.
+type StartupProtectionConfig struct {
+	UpdateDelay           *int64
+	MaxMedOnStartupPeriod *int64
+	MaxMedOnStartupValue  *uint32
+}
+
+// ImportConfig represents a vrf the routes are leaked from into a router.
+// When Prefixes is not empty, only the routes matching them are leaked.
+type ImportConfig struct {
+	VRF      string
+	Prefixes []IncomingFilter
+}
+
+// ImportsFiltered tells if any of the imports of the given family
+// ("ipv4" or "ipv6") leaks only a subset of the routes.
+func (r *RouterConfig) ImportsFiltered(family string) bool {
+	imports := r.IPV4Imports
+	if family == "ipv6" {
+		imports = r.IPV6Imports
+	}
+	for _, i := range imports {
+		if len(i.Prefixes) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfederationPeers returns the member ASes of the confederation
+// the router is part of, if any.
+func (r *RouterConfig) ConfederationPeers() []uint32 {
+	if r.Confederation == nil {
+		return nil
+	}
+	return r.Confederation.Peers
+}
+
+func (r *RouterConfig) ImportRouteMap(family string) string {
+	return fmt.Sprintf("%s-import-%s", r.vrfName(), family)
+}
+
+func (r *RouterConfig) ImportPrefixList(family, vrf string) string {
+	return fmt.Sprintf("%s-import-%s-%s", r.vrfName(), vrf, family)
+}
+
+func (r *RouterConfig) vrfName() string {
+	if r.VRF == "" {
+		return "default"
+	}
+	return r.VRF
+}
+
+// RedistributeConfig represents a source of routes redistributed into
+// the BGP instance of a router.
+// When both PrefixesV4 and PrefixesV6 are empty, all the routes of the
+// source are redistributed.
+type RedistributeConfig struct {
+	Source     string
+	Table      uint32
+	Metric     *uint32
+	PrefixesV4 []IncomingFilter
+	PrefixesV6 []IncomingFilter
+	VRF        string
+}
+
+// Command returns the source as expected by the redistribute command.
+func (r RedistributeConfig) Command() string {
+	if r.Source == "table" {
+		return fmt.Sprintf("table %d", r.Table)
+	}
+	return r.Source
+}
+
+func (r RedistributeConfig) RouteMapName() string {
+	name := "redistribute-" + strings.ReplaceAll(r.Command(), " ", "-")
+	if r.VRF != "" {
+		name = r.VRF + "-" + name
+	}
+	return name
+}
+
+func (r RedistributeConfig) PrefixListV4() string {
+	return r.RouteMapName() + "-ipv4"
+}
+
+func (r RedistributeConfig) PrefixListV6() string {
+	return r.RouteMapName() + "-ipv6"
+}
+
+// AllPrefixes tells if all the routes of the source are redistributed.
+func (r RedistributeConfig) AllPrefixes() bool {
+	return len(r.PrefixesV4) == 0 && len(r.PrefixesV6) == 0
+}
+
+// AggregateConfig represents a summary prefix of a router.
+type AggregateConfig struct {
+	Prefix      string
+	SummaryOnly bool
+	ASSet       bool
+}
+
+// BestPathConfig holds the best path selection and multipath settings
+// of a router.
+type BestPathConfig struct {
+	MaximumPathsEBGP     *uint32
+	MaximumPathsIBGP     *uint32
+	ASPathMultipathRelax bool
+	DeterministicMED     bool
+	CompareRouterID      bool
+	AlwaysCompareMED     bool
 }
 
 type BFDProfile struct {
@@ -82,13 +274,56 @@ type NeighborConfig struct {
 	Password        string
 	BFDProfile      string
 	GracefulRestart bool
-	EBGPMultiHop    bool
-	LocalASN        uint32
-	VRFName         string
-	Incoming        AllowedIn
-	Outgoing        AllowedOut
-	AlwaysBlock     []IncomingFilter
-	AddressFamilies []string
+	// GracefulRestartMode is the restricted graceful restart behavior of
+	// the neighbor, "helper" or "disable". Empty when not restricted.
+	GracefulRestartMode string
+	Description         string
+	Shutdown            bool
+	ShutdownMessage     string
+	EBGPMultiHop        bool
+	TTLSecurityHops     *uint32
+	Passive             bool
+	ExtendedNextHop     bool
+	LocalASN            uint32
+	VRFName             string
+	Incoming            AllowedIn
+	Outgoing            AllowedOut
+	AlwaysBlock         []IncomingFilter
+	AddressFamilies     []string
+	MaxPrefixes         *MaxPrefixes
+	// RouteReflectorClient is set when the neighbor is a route
+	// reflector client, for all its address families.
+	RouteReflectorClient bool
+	// AddPathTX is the add-path mode used to advertise paths to the
+	// neighbor, "all" or "bestpath-per-as". Empty if disabled.
+	AddPathTX         string
+	AddPathRXDisabled bool
+	// AllowASIn is the argument of allowas-in, a count or "origin".
+	// Empty if disabled.
+	AllowASIn       string
+	ASOverride      bool
+	RemovePrivateAS *RemovePrivateAS
+	// ListenRange is set when the neighbor is a peer group accepting
+	// dynamic sessions from the given subnet. In that case, Addr holds
+	// the name of the peer group.
+	ListenRange string
+}
+
+// RemovePrivateAS tells how the private AS numbers are removed
+// from the AS path of the routes advertised to a neighbor.
+type RemovePrivateAS struct {
+	All       bool
+	ReplaceAS bool
+}
+
+// MaxPrefixes is the maximum number of prefixes accepted from a neighbor
+// for each address family.
+type MaxPrefixes struct {
+	IPv4        *uint32
+	IPv6        *uint32
+	WarningOnly bool
+	// RestartInterval is expressed in minutes.
+	RestartInterval *uint32
 }
 
 func (n *NeighborConfig) ID() string {
@@ -111,32 +346,104 @@ func (n *NeighborConfig) ToAdvertisePrefixListV6() string {
 	return fmt.Sprintf("%s-allowed-%s", n.ID(), "ipv6")
 }
 
+func (n *NeighborConfig) AdvertiseMapV4() string {
+	return fmt.Sprintf("%s-advertise-%s", n.ID(), "ipv4")
+}
+
+func (n *NeighborConfig) AdvertiseMapV6() string {
+	return fmt.Sprintf("%s-advertise-%s", n.ID(), "ipv6")
+}
+
+func (n *NeighborConfig) ConditionMapV4() string {
+	return fmt.Sprintf("%s-condition-%s", n.ID(), "ipv4")
+}
+
+func (n *NeighborConfig) ConditionMapV6() string {
+	return fmt.Sprintf("%s-condition-%s", n.ID(), "ipv6")
+}
+
+func (n *NeighborConfig) DefaultOriginateRouteMapV4() string {
+	return fmt.Sprintf("%s-default-originate-%s", n.ID(), "ipv4")
+}
+
+func (n *NeighborConfig) DefaultOriginateRouteMapV6() string {
+	return fmt.Sprintf("%s-default-originate-%s", n.ID(), "ipv6")
+}
+
 type AllowedIn struct {
-	All        bool
-	PrefixesV4 []IncomingFilter
-	PrefixesV6 []IncomingFilter
+	All                        bool
+	PrefixesV4                 []IncomingFilter
+	PrefixesV6                 []IncomingFilter
+	Communities                []string
+	LargeCommunities           []string
+	ASPaths                    []string
+	LocalPrefPrefixesModifiers []LocalPrefPrefixList
+	CommunityPrefixesModifiers []CommunityPrefixList
+	WeightPrefixesModifiers    []WeightPrefixList
 }
 
 func (a *AllowedIn) AllPrefixes() []IncomingFilter {
 	return append(a.PrefixesV4, a.PrefixesV6...)
 }
 
+func (a AllowedIn) PrefixLists() []PropertyPrefixList {
+	res := make([]PropertyPrefixList, 0, len(a.LocalPrefPrefixesModifiers)+len(a.CommunityPrefixesModifiers)+
+		len(a.WeightPrefixesModifiers))
+	for _, v := range a.LocalPrefPrefixesModifiers {
+		res = append(res, v)
+	}
+	for _, v := range a.CommunityPrefixesModifiers {
+		res = append(res, v)
+	}
+	for _, v := range a.WeightPrefixesModifiers {
+		res = append(res, v)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].PrefixListName() < res[j].PrefixListName()
+	})
+
+	return res
+}
+
 type AllowedOut struct {
-	PrefixesV4                 []string
-	PrefixesV6                 []string
-	NextHopV4                  string
-	NextHopV6                  string
-	LocalPrefPrefixesModifiers []LocalPrefPrefixList
-	CommunityPrefixesModifiers []CommunityPrefixList
+	PrefixesV4                     []string
+	PrefixesV6                     []string
+	NextHopV4                      string
+	NextHopV6                      string
+	Redistributed                  bool
+	DefaultOriginateV4             *DefaultOriginate
+	DefaultOriginateV6             *DefaultOriginate
+	ConditionalV4                  *ConditionalAdvertisement
+	ConditionalV6                  *ConditionalAdvertisement
+	LocalPrefPrefixesModifiers     []LocalPrefPrefixList
+	CommunityPrefixesModifiers     []CommunityPrefixList
+	ASPathPrependPrefixesModifiers []ASPathPrependPrefixList
+	MEDPrefixesModifiers           []MEDPrefixList
+	OriginPrefixesModifiers        []OriginPrefixList
+	LinkBandwidthPrefixesModifiers []LinkBandwidthPrefixList
 }
 
 func (a AllowedOut) PrefixLists() []PropertyPrefixList {
-	res := make([]PropertyPrefixList, len(a.LocalPrefPrefixesModifiers)+len(a.CommunityPrefixesModifiers))
-	for i, v := range a.LocalPrefPrefixesModifiers {
-		res[i] = v
+	res := make([]PropertyPrefixList, 0, len(a.LocalPrefPrefixesModifiers)+len(a.CommunityPrefixesModifiers)+
+		len(a.ASPathPrependPrefixesModifiers)+len(a.MEDPrefixesModifiers)+len(a.OriginPrefixesModifiers)+
+		len(a.LinkBandwidthPrefixesModifiers))
+	for _, v := range a.LocalPrefPrefixesModifiers {
+		res = append(res, v)
+	}
+	for _, v := range a.CommunityPrefixesModifiers {
+		res = append(res, v)
+	}
+	for _, v := range a.ASPathPrependPrefixesModifiers {
+		res = append(res, v)
+	}
+	for _, v := range a.MEDPrefixesModifiers {
+		res = append(res, v)
 	}
-	for i, v := range a.CommunityPrefixesModifiers {
-		res[i+len(a.LocalPrefPrefixesModifiers)] = v
+	for _, v := range a.OriginPrefixesModifiers {
+		res = append(res, v)
+	}
+	for _, v := range a.LinkBandwidthPrefixesModifiers {
+		res = append(res, v)
 	}
 	sort.Slice(res, func(i, j int) bool {
 		return res[i].PrefixListName() < res[j].PrefixListName()
@@ -145,6 +452,22 @@ func (a AllowedOut) PrefixLists() []PropertyPrefixList {
 	return res
 }
 
+// ConditionalAdvertisement represents a list of prefixes advertised to a
+// neighbor depending on the presence (Condition "exist") or absence
+// (Condition "non-exist") of a prefix matching ConditionPrefix in the BGP table.
+type ConditionalAdvertisement struct {
+	Prefixes        []string
+	Condition       string
+	ConditionPrefix IncomingFilter
+}
+
+// DefaultOriginate represents a default route advertised to a neighbor.
+// When ConditionPrefix is set, the route is advertised only if the prefix
+// is in the BGP table.
+type DefaultOriginate struct {
+	ConditionPrefix string
+}
+
 type CommunityPrefixList struct {
 	PrefixList
 	Community community.BGPCommunity
@@ -154,6 +477,9 @@ func (pl CommunityPrefixList) SetStatement() string {
 	if community.IsLarge(pl.Community) {
 		return fmt.Sprintf("set large-community %s additive", pl.Community.String())
 	}
+	if extended, ok := pl.Community.(community.BGPCommunityExtended); ok {
+		return fmt.Sprintf("set extcommunity %s %s additive", extended.Type(), extended.String())
+	}
 	return fmt.Sprintf("set community %s additive", pl.Community.String())
 }
 
@@ -180,6 +506,56 @@ func (pl LocalPrefPrefixList) SetStatement() string {
 	return fmt.Sprintf("set local-preference %d", pl.LocalPref)
 }
 
+type ASPathPrependPrefixList struct {
+	PrefixList
+	ASN    uint32
+	Repeat uint32
+}
+
+func (pl ASPathPrependPrefixList) SetStatement() string {
+	asns := make([]string, 0, pl.Repeat)
+	for i := uint32(0); i < pl.Repeat; i++ {
+		asns = append(asns, strconv.FormatUint(uint64(pl.ASN), 10))
+	}
+	return fmt.Sprintf("set as-path prepend %s", strings.Join(asns, " "))
+}
+
+type MEDPrefixList struct {
+	PrefixList
+	MED uint32
+}
+
+func (pl MEDPrefixList) SetStatement() string {
+	return fmt.Sprintf("set metric %d", pl.MED)
+}
+
+type OriginPrefixList struct {
+	PrefixList
+	Origin string
+}
+
+func (pl OriginPrefixList) SetStatement() string {
+	return fmt.Sprintf("set origin %s", pl.Origin)
+}
+
+type LinkBandwidthPrefixList struct {
+	PrefixList
+	LinkBandwidth community.BGPCommunityExtended
+}
+
+func (pl LinkBandwidthPrefixList) SetStatement() string {
+	return fmt.Sprintf("set extcommunity %s %s", pl.LinkBandwidth.Type(), pl.LinkBandwidth.String())
+}
+
+type WeightPrefixList struct {
+	PrefixList
+	Weight uint32
+}
+
+func (pl WeightPrefixList) SetStatement() string {
+	return fmt.Sprintf("set weight %d", pl.Weight)
+}
+
 type PropertyPrefixList interface {
 	SetStatement() string
 	PrefixListName() string
@@ -278,7 +654,16 @@ func templateConfig(data interface{}) (string, error) {
 			"deniedIncomingList": func(neighbor *NeighborConfig) string {
 				return fmt.Sprintf("%s-denied-inpl-%s", neighbor.ID(), neighbor.IPFamily)
 			},
-			"mustDisableConnectedCheck": func(ipFamily ipfamily.Family, myASN uint32, asn, iface string, eBGPMultiHop bool) bool {
+			"allowedIncomingCommunityList": func(neighbor *NeighborConfig) string {
+				return fmt.Sprintf("%s-in-community", neighbor.ID())
+			},
+			"allowedIncomingLargeCommunityList": func(neighbor *NeighborConfig) string {
+				return fmt.Sprintf("%s-in-large-community", neighbor.ID())
+			},
+			"allowedIncomingASPathList": func(neighbor *NeighborConfig) string {
+				return fmt.Sprintf("%s-in-aspath", neighbor.ID())
+			},
+			"mustDisableConnectedCheck": func(ipFamily ipfamily.Family, myASN uint32, confederationPeers []uint32, asn, iface string, eBGPMultiHop bool) bool {
 				// return true only for non-multihop IPv6 eBGP sessions
 
 				if ipFamily != ipfamily.IPv6 {
@@ -304,6 +689,14 @@ func templateConfig(data interface{}) (string, error) {
 				}
 
 				// the peer's asn is not dynamic (it is a number),
+				// sessions with the members of our confederation are not
+				// plain eBGP ones
+				for _, p := range confederationPeers {
+					if strconv.FormatUint(uint64(p), 10) == asn {
+						return false
+					}
+				}
+
 				// we check if it is different than ours for eBGP
 				if strconv.FormatUint(uint64(myASN), 10) != asn {
 					return true
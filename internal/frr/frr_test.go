@@ -148,6 +148,424 @@ func TestSingleSession(t *testing.T) {
 	testCheckConfigFile(t)
 }
 
+func TestSingleSessionWithGracefulShutdown(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Outgoing: AllowedOut{
+							PrefixesV4: []string{
+								"192.169.1.0/24",
+							},
+						},
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.0/24"},
+			},
+		},
+		GracefulShutdown: true,
+		Loglevel:         LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestStartupProtection(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+					},
+				},
+				StartupProtection: &StartupProtectionConfig{
+					UpdateDelay:           ptr.To[int64](120),
+					MaxMedOnStartupPeriod: ptr.To[int64](300),
+				},
+			},
+			{
+				MyASN: 65000,
+				VRF:   "red",
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65002",
+						Addr:     "192.168.2.2",
+					},
+				},
+				StartupProtection: &StartupProtectionConfig{
+					MaxMedOnStartupPeriod: ptr.To[int64](60),
+					MaxMedOnStartupValue:  ptr.To[uint32](1000),
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestGracefulRestart(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				GracefulRestart: &GracefulRestartConfig{
+					RestartTime:        ptr.To[int64](60),
+					StalePathTime:      ptr.To[int64](120),
+					SelectDeferTime:    ptr.To[int64](30),
+					LongLivedStaleTime: ptr.To[int64](3600),
+				},
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily:        ipfamily.IPv4,
+						ASN:             "65001",
+						Addr:            "192.168.1.2",
+						GracefulRestart: true,
+					},
+					{
+						IPFamily:            ipfamily.IPv4,
+						ASN:                 "65002",
+						Addr:                "192.168.1.3",
+						GracefulRestartMode: "helper",
+					},
+					{
+						IPFamily:            ipfamily.IPv4,
+						ASN:                 "65003",
+						Addr:                "192.168.1.4",
+						GracefulRestartMode: "disable",
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestNeighborShutdownAndDescription(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily:        ipfamily.IPv4,
+						ASN:             "65001",
+						Addr:            "192.168.1.2",
+						Description:     "tor switch A",
+						Shutdown:        true,
+						ShutdownMessage: "maintenance of the link",
+					},
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65002",
+						Addr:     "192.168.1.3",
+						Shutdown: true,
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestASPathLoopSettings(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily:   ipfamily.DualStack,
+						ASN:        "65001",
+						Addr:       "192.168.1.2",
+						AllowASIn:  "2",
+						ASOverride: true,
+						RemovePrivateAS: &RemovePrivateAS{
+							All:       true,
+							ReplaceAS: true,
+						},
+					},
+					{
+						IPFamily:        ipfamily.IPv4,
+						ASN:             "65002",
+						Addr:            "192.168.1.3",
+						AllowASIn:       "origin",
+						RemovePrivateAS: &RemovePrivateAS{},
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestTTLSecurityAndPassive(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily:        ipfamily.IPv4,
+						ASN:             "65001",
+						Addr:            "192.168.1.2",
+						TTLSecurityHops: ptr.To[uint32](1),
+					},
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65002",
+						Addr:     "192.168.1.3",
+						Passive:  true,
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestConfederation(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Confederation: &ConfederationConfig{
+					Identifier: 64512,
+					Peers:      []uint32{65001, 65002},
+				},
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv6,
+						ASN:      "65001",
+						Addr:     "fc00:f853:ccd:e793::2",
+					},
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65002",
+						Addr:     "192.168.1.3",
+					},
+					{
+						IPFamily: ipfamily.IPv6,
+						ASN:      "65100",
+						Addr:     "fc00:f853:ccd:e793::4",
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestExtendedNextHop(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily:        ipfamily.DualStack,
+						ASN:             "65001",
+						Addr:            "fc00:f853:ccd:e793::2",
+						ExtendedNextHop: true,
+						Outgoing: AllowedOut{
+							PrefixesV4: []string{"192.169.1.0/24"},
+						},
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.0/24"},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithLinkBandwidth(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	bandwidth, err := community.NewLinkBandwidth(100)
+	if err != nil {
+		t.Fatalf("failed to create link bandwidth: %s", err)
+	}
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Outgoing: AllowedOut{
+							PrefixesV4: []string{"192.169.1.0/24", "192.170.1.0/22"},
+							LinkBandwidthPrefixesModifiers: []LinkBandwidthPrefixList{
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-100-ip-linkbandwidth-prefixes",
+										IPFamily: "ip",
+										Prefixes: sets.New("192.169.1.0/24"),
+									},
+									LinkBandwidth: bandwidth,
+								},
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-num-multipaths-ip-linkbandwidth-prefixes",
+										IPFamily: "ip",
+										Prefixes: sets.New("192.170.1.0/22"),
+									},
+									LinkBandwidth: community.NewLinkBandwidthNumMultipaths(),
+								},
+							},
+						},
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.0/24", "192.170.1.0/22"},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err = frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithExtendedCommunities(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Outgoing: AllowedOut{
+							PrefixesV4: []string{"192.169.1.0/24", "192.170.1.0/22"},
+							CommunityPrefixesModifiers: []CommunityPrefixList{
+								communityPrefixListFor("192.168.1.2", "rt:65000:100", "ip", "192.169.1.0/24", "192.170.1.0/22"),
+								communityPrefixListFor("192.168.1.2", "soo:192.0.2.1:10", "ip", "192.170.1.0/22"),
+							},
+						},
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.0/24", "192.170.1.0/22"},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
 func TestSingleSessionWithNextHopV4(t *testing.T) {
 	testSetup(t)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -163,17 +581,642 @@ func TestSingleSessionWithNextHopV4(t *testing.T) {
 						IPFamily: ipfamily.IPv4,
 						ASN:      "65001",
 						Addr:     "192.168.1.2",
-						Port:     ptr.To[uint16](4567),
+						Port:     ptr.To[uint16](4567),
+						Outgoing: AllowedOut{
+							PrefixesV4: []string{
+								"192.169.1.0/24",
+								"192.170.1.0/22",
+							},
+							NextHopV4: "192.168.1.1",
+						},
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.0/24", "192.170.1.0/22"},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithNextHopV6(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv6,
+						ASN:      "65001",
+						Addr:     "2001:db8::1",
+						Outgoing: AllowedOut{
+							PrefixesV6: []string{
+								"2001:db8:abcd::/48",
+							},
+							NextHopV6: "2001:db8::2",
+						},
+					},
+				},
+				IPV6Prefixes: []string{"2001:db8:abcd::/48"},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithPathAttributes(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.DualStack,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Outgoing: AllowedOut{
+							PrefixesV4: []string{"192.169.1.0/24", "192.170.1.0/22"},
+							PrefixesV6: []string{"2001:db8::/64"},
+							ASPathPrependPrefixesModifiers: []ASPathPrependPrefixList{
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-65000x3-ip-aspathprepend-prefixes",
+										IPFamily: "ip",
+										Prefixes: sets.New("192.169.1.0/24"),
+									},
+									ASN:    65000,
+									Repeat: 3,
+								},
+							},
+							MEDPrefixesModifiers: []MEDPrefixList{
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-50-ip-med-prefixes",
+										IPFamily: "ip",
+										Prefixes: sets.New("192.169.1.0/24", "192.170.1.0/22"),
+									},
+									MED: 50,
+								},
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-50-ipv6-med-prefixes",
+										IPFamily: "ipv6",
+										Prefixes: sets.New("2001:db8::/64"),
+									},
+									MED: 50,
+								},
+							},
+							OriginPrefixesModifiers: []OriginPrefixList{
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-incomplete-ipv6-origin-prefixes",
+										IPFamily: "ipv6",
+										Prefixes: sets.New("2001:db8::/64"),
+									},
+									Origin: "incomplete",
+								},
+							},
+						},
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.0/24", "192.170.1.0/22"},
+				IPV6Prefixes: []string{"2001:db8::/64"},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithIncomingModifiers(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	largeCommunity, err := community.New("large:123:456:7890")
+	if err != nil {
+		t.Fatalf("failed to parse community: %s", err)
+	}
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.DualStack,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Incoming: AllowedIn{
+							PrefixesV4: []IncomingFilter{
+								{
+									IPFamily: ipfamily.IPv4,
+									Prefix:   "192.168.0.0/16",
+									LE:       32,
+								},
+							},
+							PrefixesV6: []IncomingFilter{
+								{
+									IPFamily: ipfamily.IPv6,
+									Prefix:   "2001:db8::/64",
+								},
+							},
+							LocalPrefPrefixesModifiers: []LocalPrefPrefixList{
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-200-ip-in-localpref-prefixes",
+										IPFamily: "ip",
+										Prefixes: sets.New("192.168.10.0/24 le 32"),
+									},
+									LocalPref: 200,
+								},
+							},
+							CommunityPrefixesModifiers: []CommunityPrefixList{
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-large:123:456:7890-ipv6-in-community-prefixes",
+										IPFamily: "ipv6",
+										Prefixes: sets.New("2001:db8::/64"),
+									},
+									Community: largeCommunity,
+								},
+							},
+							WeightPrefixesModifiers: []WeightPrefixList{
+								{
+									PrefixList: PrefixList{
+										Name:     "192.168.1.2-100-ip-in-weight-prefixes",
+										IPFamily: "ip",
+										Prefixes: sets.New("192.168.10.0/24 le 32", "192.168.20.0/24"),
+									},
+									Weight: 100,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err = frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithIncomingCommunitiesAndASPaths(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.DualStack,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Incoming: AllowedIn{
+							PrefixesV4: []IncomingFilter{
+								{
+									IPFamily: ipfamily.IPv4,
+									Prefix:   "192.168.0.0/16",
+								},
+							},
+							Communities:      []string{"10:100", "10:200"},
+							LargeCommunities: []string{"123:456:7890"},
+							ASPaths:          []string{"^65001_", "_65010$"},
+						},
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithIncomingCommunitiesAndASPathsOnly(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.DualStack,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Incoming: AllowedIn{
+							Communities: []string{"10:100"},
+							ASPaths:     []string{"^65001_"},
+						},
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithMaxPrefixes(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.DualStack,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						MaxPrefixes: &MaxPrefixes{
+							IPv4:            ptr.To[uint32](1000),
+							IPv6:            ptr.To[uint32](500),
+							RestartInterval: ptr.To[uint32](5),
+						},
+					},
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65002",
+						Addr:     "192.168.1.3",
+						MaxPrefixes: &MaxPrefixes{
+							IPv4:        ptr.To[uint32](100),
+							WarningOnly: true,
+						},
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithListenRanges(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN:       65000,
+				ListenLimit: ptr.To[uint32](50),
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+					},
+					{
+						IPFamily:      ipfamily.IPv4,
+						ASN:           "external",
+						Addr:          "listen-192.168.10.0-24",
+						ListenRange:   "192.168.10.0/24",
+						HoldTime:      ptr.To[int64](90),
+						KeepaliveTime: ptr.To[int64](30),
+						Incoming: AllowedIn{
+							All: true,
+						},
+					},
+					{
+						IPFamily:    ipfamily.IPv6,
+						ASN:         "65002",
+						Addr:        "listen-fc00:f853:ccd:e800::-64",
+						ListenRange: "fc00:f853:ccd:e800::/64",
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithRouteReflectorClients(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN:     65000,
+				RouterID:  "10.0.0.1",
+				ClusterID: "10.0.0.100",
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily:             ipfamily.DualStack,
+						ASN:                  "65000",
+						Addr:                 "192.168.1.2",
+						RouteReflectorClient: true,
+						AddressFamilies:      []string{"unicast", "evpn"},
+					},
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65000",
+						Addr:     "192.168.1.3",
+					},
+				},
+				EVPN: &EVPNConfig{
+					AdvertiseVNIs: ptr.To("All"),
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithBestPath(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				BestPath: &BestPathConfig{
+					MaximumPathsEBGP:     ptr.To[uint32](8),
+					MaximumPathsIBGP:     ptr.To[uint32](4),
+					ASPathMultipathRelax: true,
+					DeterministicMED:     true,
+					CompareRouterID:      true,
+					AlwaysCompareMED:     true,
+				},
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.0/24"},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithAddPath(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily:  ipfamily.DualStack,
+						ASN:       "65000",
+						Addr:      "192.168.1.2",
+						AddPathTX: "all",
+					},
+					{
+						IPFamily:          ipfamily.IPv4,
+						ASN:               "65001",
+						Addr:              "192.168.1.3",
+						AddPathTX:         "bestpath-per-as",
+						AddPathRXDisabled: true,
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithRedistribute(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.DualStack,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Outgoing: AllowedOut{
+							PrefixesV4:    []string{"192.169.1.0/24"},
+							Redistributed: true,
+						},
+					},
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65002",
+						Addr:     "192.168.1.3",
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.0/24"},
+				Redistribute: []RedistributeConfig{
+					{
+						Source: "connected",
+						Metric: ptr.To[uint32](100),
+						PrefixesV4: []IncomingFilter{
+							{
+								IPFamily: ipfamily.IPv4,
+								Prefix:   "10.0.0.0/8",
+								GE:       24,
+							},
+						},
+					},
+					{
+						Source: "static",
+					},
+					{
+						Source: "table",
+						Table:  10,
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithAggregates(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.DualStack,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
 						Outgoing: AllowedOut{
-							PrefixesV4: []string{
-								"192.169.1.0/24",
-								"192.170.1.0/22",
+							PrefixesV4: []string{"192.169.0.0/16"},
+							PrefixesV6: []string{"2001:db8::/32"},
+						},
+					},
+				},
+				IPV4Prefixes: []string{"192.169.1.1/32", "192.169.1.2/32"},
+				IPV4Aggregates: []AggregateConfig{
+					{
+						Prefix:      "192.169.0.0/16",
+						SummaryOnly: true,
+						ASSet:       true,
+					},
+				},
+				IPV6Aggregates: []AggregateConfig{
+					{
+						Prefix: "2001:db8::/32",
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
+func TestSingleSessionWithConditionalAdvertisement(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				Neighbors: []*NeighborConfig{
+					{
+						IPFamily: ipfamily.DualStack,
+						ASN:      "65001",
+						Addr:     "192.168.1.2",
+						Outgoing: AllowedOut{
+							PrefixesV4: []string{"192.169.1.0/24", "192.170.1.0/24"},
+							PrefixesV6: []string{"2001:db8:1::/64"},
+							ConditionalV4: &ConditionalAdvertisement{
+								Prefixes:  []string{"192.169.1.0/24", "192.170.1.0/24"},
+								Condition: "non-exist",
+								ConditionPrefix: IncomingFilter{
+									IPFamily: ipfamily.IPv4,
+									Prefix:   "10.0.0.0/8",
+									LE:       24,
+								},
+							},
+							ConditionalV6: &ConditionalAdvertisement{
+								Prefixes:  []string{"2001:db8:1::/64"},
+								Condition: "exist",
+								ConditionPrefix: IncomingFilter{
+									IPFamily: ipfamily.IPv6,
+									Prefix:   "2001:db8:2::/64",
+								},
 							},
-							NextHopV4: "192.168.1.1",
 						},
 					},
 				},
-				IPV4Prefixes: []string{"192.169.1.0/24", "192.170.1.0/22"},
+				IPV4Prefixes: []string{"192.169.1.0/24", "192.170.1.0/24"},
+				IPV6Prefixes: []string{"2001:db8:1::/64"},
 			},
 		},
 		Loglevel: LevelFrom(logging.LevelInfo),
@@ -186,7 +1229,7 @@ func TestSingleSessionWithNextHopV4(t *testing.T) {
 	testCheckConfigFile(t)
 }
 
-func TestSingleSessionWithNextHopV6(t *testing.T) {
+func TestSingleSessionWithDefaultOriginate(t *testing.T) {
 	testSetup(t)
 	ctx, cancel := context.WithCancel(context.Background())
 	frr := testNewFRR(t, ctx)
@@ -198,18 +1241,29 @@ func TestSingleSessionWithNextHopV6(t *testing.T) {
 				MyASN: 65000,
 				Neighbors: []*NeighborConfig{
 					{
-						IPFamily: ipfamily.IPv6,
+						IPFamily: ipfamily.DualStack,
 						ASN:      "65001",
-						Addr:     "2001:db8::1",
+						Addr:     "192.168.1.2",
 						Outgoing: AllowedOut{
-							PrefixesV6: []string{
-								"2001:db8:abcd::/48",
+							PrefixesV4:         []string{"0.0.0.0/0"},
+							DefaultOriginateV4: &DefaultOriginate{},
+							DefaultOriginateV6: &DefaultOriginate{
+								ConditionPrefix: "2001:db8::/64",
+							},
+						},
+					},
+					{
+						IPFamily: ipfamily.IPv4,
+						ASN:      "65002",
+						Addr:     "192.168.1.3",
+						Outgoing: AllowedOut{
+							DefaultOriginateV4: &DefaultOriginate{
+								ConditionPrefix: "192.169.1.0/24",
 							},
-							NextHopV6: "2001:db8::2",
 						},
 					},
 				},
-				IPV6Prefixes: []string{"2001:db8:abcd::/48"},
+				IPV4Prefixes: []string{"192.169.1.0/24"},
 			},
 		},
 		Loglevel: LevelFrom(logging.LevelInfo),
@@ -923,6 +1977,61 @@ func TestSingleSessionWithLocalASN(t *testing.T) {
 	testCheckConfigFile(t)
 }
 
+func TestMultipleRoutersFilteredImportVRFs(t *testing.T) {
+	testSetup(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	frr := testNewFRR(t, ctx)
+
+	config := Config{
+		Routers: []*RouterConfig{
+			{
+				MyASN:        65000,
+				IPV4Prefixes: []string{"192.169.1.0/24"},
+				IPV4Imports: []ImportConfig{
+					{
+						VRF: "red",
+						Prefixes: []IncomingFilter{
+							{IPFamily: ipfamily.IPv4, Prefix: "192.171.0.0/16", LE: 24},
+						},
+					},
+					{VRF: "blue"},
+				},
+				IPV6Imports: []ImportConfig{{VRF: "blue"}},
+			},
+			{
+				MyASN:        65000,
+				VRF:          "red",
+				IPV4Prefixes: []string{"192.171.1.0/24"},
+			},
+			{
+				MyASN:        65000,
+				VRF:          "blue",
+				IPV4Prefixes: []string{"192.172.1.0/24"},
+				IPV6Prefixes: []string{"2001:db9:abcd::/48"},
+				IPV6Imports: []ImportConfig{
+					{
+						VRF: "default",
+						Prefixes: []IncomingFilter{
+							{IPFamily: ipfamily.IPv6, Prefix: "2001:db8:abcd::/48"},
+						},
+					},
+				},
+			},
+		},
+		Loglevel: LevelFrom(logging.LevelInfo),
+	}
+
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
 func TestMultipleRoutersImportVRFs(t *testing.T) {
 	testSetup(t)
 
@@ -945,7 +2054,8 @@ func TestMultipleRoutersImportVRFs(t *testing.T) {
 				},
 				IPV4Prefixes: []string{"192.169.1.0/24"},
 				IPV6Prefixes: []string{"2001:db8:abcd::/48"},
-				ImportVRFs:   []string{"red"},
+				IPV4Imports:  []ImportConfig{{VRF: "red"}},
+				IPV6Imports:  []ImportConfig{{VRF: "red"}},
 			},
 			{
 				MyASN:        65000,
@@ -957,7 +2067,8 @@ func TestMultipleRoutersImportVRFs(t *testing.T) {
 				VRF:          "blue",
 				IPV4Prefixes: []string{"192.171.1.0/24"},
 				IPV6Prefixes: []string{"2001:db9:abcd::/48"},
-				ImportVRFs:   []string{"default"},
+				IPV4Imports:  []ImportConfig{{VRF: "default"}},
+				IPV6Imports:  []ImportConfig{{VRF: "default"}},
 			},
 		},
 		Loglevel: LevelFrom(logging.LevelInfo),
@@ -1260,6 +2371,74 @@ func TestEVPNWithL3VNI(t *testing.T) {
 	testCheckConfigFile(t)
 }
 
+func TestStaticRoutes(t *testing.T) {
+	testSetup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	frr := testNewFRR(t, ctx)
+	defer cancel()
+
+	config := Config{
+		Loglevel: LevelFrom(logging.LevelInfo),
+		Routers: []*RouterConfig{
+			{
+				MyASN: 65000,
+				VRF:   "red",
+				EVPN: &EVPNConfig{
+					L3VNI: &L3VNI{
+						VNI: 3000,
+						VNIProperties: VNIProperties{
+							RD:        "65000:3000",
+							ImportRTs: []string{"65000:3000"},
+							ExportRTs: []string{"65000:3000"},
+						},
+						AdvertisePrefixes: []string{"unicast"},
+					},
+				},
+			},
+		},
+		StaticRoutes: []StaticRouteConfig{
+			{
+				IPFamily:   ipfamily.IPv4,
+				Prefix:     "192.168.10.0/24",
+				NextHop:    "192.168.1.1",
+				Tag:        ptr.To[uint32](100),
+				Distance:   ptr.To[uint32](10),
+				BFDProfile: "bfdprofile",
+			},
+			{
+				IPFamily:  ipfamily.IPv6,
+				Prefix:    "2001:db8::/64",
+				Interface: "eth0",
+			},
+			{
+				IPFamily:   ipfamily.IPv4,
+				Prefix:     "192.168.20.0/24",
+				NextHop:    "192.168.1.1",
+				VRF:        "blue",
+				NextHopVRF: "default",
+			},
+			{
+				IPFamily:  ipfamily.IPv4,
+				Prefix:    "192.168.30.0/24",
+				NextHop:   "10.0.0.1",
+				Interface: "br0",
+				VRF:       "red",
+			},
+		},
+		BFDProfiles: []BFDProfile{
+			{
+				Name: "bfdprofile",
+			},
+		},
+	}
+	err := frr.ApplyConfig(&config)
+	if err != nil {
+		t.Fatalf("Failed to apply config: %s", err)
+	}
+
+	testCheckConfigFile(t)
+}
+
 func TestEVPNNeighborOnlyEVPN(t *testing.T) {
 	testSetup(t)
 	ctx, cancel := context.WithCancel(context.Background())
@@ -1425,5 +2604,8 @@ func communityPrefixListName(neighborID string, comm community.BGPCommunity, ipF
 	if community.IsLarge(comm) {
 		return fmt.Sprintf("%s-large:%s-%s-community-prefixes", neighborID, comm, ipFamily)
 	}
+	if extended, ok := comm.(community.BGPCommunityExtended); ok {
+		return fmt.Sprintf("%s-%s:%s-%s-community-prefixes", neighborID, extended.Type(), comm, ipFamily)
+	}
 	return fmt.Sprintf("%s-%s-%s-community-prefixes", neighborID, comm, ipFamily)
 }
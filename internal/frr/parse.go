@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"slices"
 	"sort"
 	"strconv"
 
@@ -25,6 +26,11 @@ type Neighbor struct {
 	RemoteRouterID string
 	MsgStats       MessageStats
 	BFDStatus      string
+	// AdminShutdown is set when the session is administratively shut down.
+	AdminShutdown bool
+	// MaxPrefixes holds the configured maximum number of prefixes
+	// accepted from the neighbor, by address family.
+	MaxPrefixes map[string]int
 }
 
 func (n Neighbor) MetricName() string {
@@ -53,8 +59,10 @@ type FRRNeighbor struct {
 	AddressFamilyInfo map[string]struct {
 		SentPrefixCounter     int `json:"sentPrefixCounter"`
 		AcceptedPrefixCounter int `json:"acceptedPrefixCounter"`
+		PrefixAllowedMax      int `json:"prefixAllowedMax"`
 	} `json:"addressFamilyInfo"`
-	BFDInfo PeerBFDInfo `json:"peerBfdInfo"`
+	BFDInfo       PeerBFDInfo `json:"peerBfdInfo"`
+	AdminShutDown bool        `json:"adminShutDown"`
 }
 
 type MessageStats struct {
@@ -79,14 +87,16 @@ type IPInfo struct {
 }
 
 type FRRRoute struct {
-	Valid     bool   `json:"valid"`
-	PeerID    string `json:"peerId"`
-	LocalPref uint32 `json:"locPrf"`
-	Origin    string `json:"origin"`
-	Nexthops  []struct {
-		IP    string `json:"ip"`
-		Scope string `json:"scope"`
-	} `json:"nexthops"`
+	Valid     bool         `json:"valid"`
+	PeerID    string       `json:"peerId"`
+	LocalPref uint32       `json:"locPrf"`
+	Origin    string       `json:"origin"`
+	Nexthops  []FRRNextHop `json:"nexthops"`
+}
+
+type FRRNextHop struct {
+	IP    string `json:"ip"`
+	Scope string `json:"scope"`
 }
 
 type BFDPeer struct {
@@ -146,9 +156,13 @@ func ParseNeighbour(vtyshRes string) (*Neighbor, error) {
 		}
 		prefixSent := 0
 		prefixReceived := 0
-		for _, s := range n.AddressFamilyInfo {
+		maxPrefixes := map[string]int{}
+		for family, s := range n.AddressFamilyInfo {
 			prefixSent += s.SentPrefixCounter
 			prefixReceived += s.AcceptedPrefixCounter
+			if s.PrefixAllowedMax > 0 {
+				maxPrefixes[family] = s.PrefixAllowedMax
+			}
 		}
 		return &Neighbor{
 			ID:             k,
@@ -162,6 +176,8 @@ func ParseNeighbour(vtyshRes string) (*Neighbor, error) {
 			RemoteRouterID: n.RemoteRouterID,
 			MsgStats:       n.MsgStats,
 			BFDStatus:      n.BFDInfo.Status,
+			AdminShutdown:  n.AdminShutDown,
+			MaxPrefixes:    maxPrefixes,
 		}, nil
 	}
 	return nil, errors.New("no peers were returned")
@@ -184,9 +200,13 @@ func ParseNeighbours(vtyshRes string) ([]*Neighbor, error) {
 		}
 		prefixSent := 0
 		prefixReceived := 0
-		for _, s := range n.AddressFamilyInfo {
+		maxPrefixes := map[string]int{}
+		for family, s := range n.AddressFamilyInfo {
 			prefixSent += s.SentPrefixCounter
 			prefixReceived += s.AcceptedPrefixCounter
+			if s.PrefixAllowedMax > 0 {
+				maxPrefixes[family] = s.PrefixAllowedMax
+			}
 		}
 		res = append(res, &Neighbor{
 			ID:             k,
@@ -200,6 +220,8 @@ func ParseNeighbours(vtyshRes string) ([]*Neighbor, error) {
 			RemoteRouterID: n.RemoteRouterID,
 			MsgStats:       n.MsgStats,
 			BFDStatus:      n.BFDInfo.Status,
+			AdminShutdown:  n.AdminShutDown,
+			MaxPrefixes:    maxPrefixes,
 		})
 	}
 	return res, nil
@@ -228,13 +250,20 @@ func ParseRoutes(vtyshRes string) (map[string]Route, error) {
 		for _, n := range frrRoutes {
 			r.LocalPref = n.LocalPref
 			r.Origin = n.Origin
+			// IPv6 next hops come with their link-local counterpart. For
+			// IPv4 routes learned with the extended next hop capability the
+			// link-local one is the only next hop on unnumbered sessions,
+			// so it is kept when there is no global one.
+			keepLinkLocal := destIP.To4() != nil && !slices.ContainsFunc(n.Nexthops, func(h FRRNextHop) bool {
+				return h.Scope != "link-local"
+			})
 		out:
 			for _, h := range n.Nexthops {
 				ip := net.ParseIP(h.IP)
 				if ip == nil {
 					return nil, fmt.Errorf("failed to parse ip %s", h.IP)
 				}
-				if ip.To4() == nil && h.Scope == "link-local" {
+				if ip.To4() == nil && h.Scope == "link-local" && !keepLinkLocal {
 					continue
 				}
 				for _, current := range r.NextHops {
@@ -362,6 +362,7 @@ const threeNeighbours = `
     "remoteRouterId":"0.0.0.0",
     "localRouterId":"172.18.0.5",
     "bgpState":"Active",
+    "adminShutDown":true,
     "bgpTimerLastRead":14000,
     "bgpTimerLastWrite":3166000,
     "bgpInUpdateElapsedTimeMsecs":3166000,
@@ -566,6 +567,12 @@ func TestNeighbours(t *testing.T) {
 	if nn[2].BFDStatus != "" {
 		t.Fatal("third neighbour bfd not matching")
 	}
+	if nn[1].AdminShutdown {
+		t.Fatal("second neighbour unexpectedly shut down")
+	}
+	if !nn[2].AdminShutdown {
+		t.Fatal("third neighbour shutdown not matching")
+	}
 
 	for i, n := range nn {
 		if !cmp.Equal(expectedStats, n.MsgStats) {
@@ -881,6 +888,122 @@ func TestRoutes(t *testing.T) {
 	}
 }
 
+func TestRoutesExtendedNextHop(t *testing.T) {
+	rr, err := ParseRoutes(routesExtendedNextHop)
+	if err != nil {
+		t.Fatalf("Failed to parse %s", err)
+	}
+
+	tests := []struct {
+		prefix   string
+		expected []net.IP
+	}{
+		{
+			prefix:   "192.168.10.0",
+			expected: []net.IP{net.ParseIP("fc00:f853:ccd:e793::2")},
+		},
+		{
+			prefix:   "192.168.20.0",
+			expected: []net.IP{net.ParseIP("fe80::dc63:3bff:fe41:f53a")},
+		},
+		{
+			prefix:   "2001:db8:20::",
+			expected: []net.IP{},
+		},
+	}
+	for _, tc := range tests {
+		r, ok := rr[tc.prefix]
+		if !ok {
+			t.Fatalf("Routes for %s not found", tc.prefix)
+		}
+		if len(r.NextHops) != len(tc.expected) {
+			t.Fatalf("expected next hops %v for %s, got %v", tc.expected, tc.prefix, r.NextHops)
+		}
+		for i := range tc.expected {
+			if !r.NextHops[i].Equal(tc.expected[i]) {
+				t.Fatalf("expected next hops %v for %s, got %v", tc.expected, tc.prefix, r.NextHops)
+			}
+		}
+	}
+}
+
+const routesExtendedNextHop = `{
+  "vrfId": 0,
+  "vrfName": "default",
+  "tableVersion": 3,
+  "routerId": "172.18.0.5",
+  "defaultLocPrf": 100,
+  "localAS": 64512,
+  "routes": { "192.168.10.0/32": [
+   {
+     "valid":true,
+     "bestpath":true,
+     "pathFrom":"external",
+     "prefix":"192.168.10.0",
+     "prefixLen":32,
+     "network":"192.168.10.0\/32",
+     "weight":0,
+     "peerId":"fc00:f853:ccd:e793::2",
+     "path":"64513",
+     "origin":"IGP",
+     "nexthops":[
+       {
+         "ip":"fc00:f853:ccd:e793::2",
+         "afi":"ipv6",
+         "scope":"global"
+       },
+       {
+         "ip":"fe80::42:acff:fe12:2",
+         "afi":"ipv6",
+         "scope":"link-local",
+         "used":true
+       }
+     ]
+   }
+ ],"192.168.20.0/32": [
+   {
+     "valid":true,
+     "bestpath":true,
+     "pathFrom":"external",
+     "prefix":"192.168.20.0",
+     "prefixLen":32,
+     "network":"192.168.20.0\/32",
+     "weight":0,
+     "peerId":"fe80::dc63:3bff:fe41:f53a",
+     "path":"64514",
+     "origin":"IGP",
+     "nexthops":[
+       {
+         "ip":"fe80::dc63:3bff:fe41:f53a",
+         "afi":"ipv6",
+         "scope":"link-local",
+         "used":true
+       }
+     ]
+   }
+ ],"2001:db8:20::/64": [
+   {
+     "valid":true,
+     "bestpath":true,
+     "pathFrom":"external",
+     "prefix":"2001:db8:20::",
+     "prefixLen":64,
+     "network":"2001:db8:20::\/64",
+     "weight":0,
+     "peerId":"fe80::dc63:3bff:fe41:f53a",
+     "path":"64514",
+     "origin":"IGP",
+     "nexthops":[
+       {
+         "ip":"fe80::dc63:3bff:fe41:f53a",
+         "afi":"ipv6",
+         "scope":"link-local",
+         "used":true
+       }
+     ]
+   }
+ ] }  }`
+
 const bfdPeers = `[
    {
       "multihop":false,
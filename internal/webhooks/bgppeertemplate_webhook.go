@@ -0,0 +1,140 @@
+// SPDX-License-Identifier:Apache-2.0
+
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/log/level"
+	"github.com/metallb/frr-k8s/api/v1beta1"
+	v1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+const (
+	bgpPeerTemplateWebhookPath = "/validate-frrk8s-metallb-io-v1beta1-bgppeertemplate"
+)
+
+type BGPPeerTemplateValidator struct {
+	decoder admission.Decoder
+}
+
+func (v *BGPPeerTemplateValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	v.decoder = admission.NewDecoder(mgr.GetScheme())
+
+	mgr.GetWebhookServer().Register(
+		bgpPeerTemplateWebhookPath,
+		&webhook.Admission{Handler: v})
+
+	return nil
+}
+
+//+kubebuilder:webhook:verbs=create;update,path=/validate-frrk8s-metallb-io-v1beta1-bgppeertemplate,mutating=false,failurePolicy=fail,groups=frrk8s.metallb.io,resources=bgppeertemplates,versions=v1beta1,name=bgppeertemplatesvalidationwebhook.metallb.io,sideEffects=None,admissionReviewVersions=v1
+
+func (v *BGPPeerTemplateValidator) Handle(ctx context.Context, req admission.Request) (resp admission.Response) {
+	var template v1beta1.BGPPeerTemplate
+	if req.Operation == v1.Delete {
+		return admission.Allowed("")
+	}
+	if err := v.decoder.Decode(req, &template); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	level.Debug(Logger).Log("webhook", "bgppeertemplate", "action", req.Operation, "name", template.Name, "namespace", template.Namespace)
+	defer level.Debug(Logger).Log("webhook", "bgppeertemplate", "action", "end "+req.Operation, "name", template.Name, "namespace", template.Namespace)
+
+	if err := validateTemplate(&template); err != nil {
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
+// validateTemplate validates the FRRConfigurations referencing the given
+// template, as they would be after the template is created or updated.
+func validateTemplate(template *v1beta1.BGPPeerTemplate) error {
+	if template.Namespace != Namespace {
+		// templates outside of the namespace are not used.
+		return nil
+	}
+
+	existingTemplates, err := getBGPPeerTemplates()
+	if err != nil {
+		return err
+	}
+	templates := &v1beta1.BGPPeerTemplateList{}
+	for _, t := range existingTemplates.Items {
+		if t.Name == template.Name {
+			continue
+		}
+		templates.Items = append(templates.Items, t)
+	}
+	templates.Items = append(templates.Items, *template)
+
+	existingFRRConfigurations, err := getFRRConfigurations()
+	if err != nil {
+		return err
+	}
+	referencing := false
+	for _, cfg := range existingFRRConfigurations.Items {
+		if referencesTemplate(cfg, template.Name) {
+			referencing = true
+			break
+		}
+	}
+	if !referencing {
+		return nil
+	}
+
+	existingNodes, err := getNodes()
+	if err != nil {
+		return err
+	}
+	for _, n := range existingNodes {
+		cfgs := &v1beta1.FRRConfigurationList{}
+		nodeReferencing := false
+		for _, cfg := range existingFRRConfigurations.Items {
+			selector, err := getCachedSelector(cfg.Spec.NodeSelector)
+			if err != nil {
+				// shouldn't happen as it would have been denied earlier, just in case.
+				continue
+			}
+			if !selector.Matches(labels.Set(n.Labels)) {
+				continue
+			}
+			cfgs.Items = append(cfgs.Items, cfg)
+			if referencesTemplate(cfg, template.Name) {
+				nodeReferencing = true
+			}
+		}
+		if !nodeReferencing {
+			continue
+		}
+		err := Validate(cfgs, templates)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("resource is invalid for node %s", n.Name))
+		}
+	}
+	return nil
+}
+
+func referencesTemplate(cfg v1beta1.FRRConfiguration, name string) bool {
+	for _, r := range cfg.Spec.BGP.Routers {
+		for _, n := range r.Neighbors {
+			if n.Template == name {
+				return true
+			}
+		}
+		for _, lr := range r.ListenRanges {
+			if lr.Template == name {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier:Apache-2.0
+
+package webhooks
+
+import (
+	"testing"
+
+	"github.com/go-kit/log"
+	"github.com/google/go-cmp/cmp"
+	"github.com/metallb/frr-k8s/api/v1beta1"
+	v1core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateBGPPeerTemplate(t *testing.T) {
+	Logger = log.NewNopLogger()
+	toRestore := getFRRConfigurations
+	toRestoreTemplates := getBGPPeerTemplates
+	toRestoreNodes := getNodes
+	toRestoreNamespace := Namespace
+	Namespace = TestNamespace
+	getNodes = func() ([]v1core.Node, error) {
+		return []v1core.Node{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "testnode",
+				},
+			},
+		}, nil
+	}
+
+	defer func() {
+		getFRRConfigurations = toRestore
+		getBGPPeerTemplates = toRestoreTemplates
+		getNodes = toRestoreNodes
+		Namespace = toRestoreNamespace
+	}()
+
+	existingTemplate := v1beta1.BGPPeerTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "template",
+			Namespace: TestNamespace,
+		},
+		Spec: v1beta1.BGPPeerTemplateSpec{
+			Neighbor: v1beta1.Neighbor{
+				ASN: 65001,
+			},
+		},
+	}
+	otherTemplate := v1beta1.BGPPeerTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other",
+			Namespace: TestNamespace,
+		},
+	}
+	updatedTemplate := v1beta1.BGPPeerTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "template",
+			Namespace: TestNamespace,
+		},
+		Spec: v1beta1.BGPPeerTemplateSpec{
+			Neighbor: v1beta1.Neighbor{
+				ASN: 65002,
+			},
+		},
+	}
+	referencingConfig := v1beta1.FRRConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "referencing",
+			Namespace: TestNamespace,
+		},
+		Spec: v1beta1.FRRConfigurationSpec{
+			BGP: v1beta1.BGPConfig{
+				Routers: []v1beta1.Router{
+					{
+						ASN: 65000,
+						Neighbors: []v1beta1.Neighbor{
+							{
+								Address:  "192.0.2.1",
+								Template: "template",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	listenRangeConfig := v1beta1.FRRConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "listen-range",
+			Namespace: TestNamespace,
+		},
+		Spec: v1beta1.FRRConfigurationSpec{
+			BGP: v1beta1.BGPConfig{
+				Routers: []v1beta1.Router{
+					{
+						ASN: 65000,
+						ListenRanges: []v1beta1.ListenRange{
+							{
+								Prefix:   "192.0.2.0/24",
+								Template: "template",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		desc              string
+		existingConfigs   []v1beta1.FRRConfiguration
+		template          *v1beta1.BGPPeerTemplate
+		failValidate      bool
+		expectedConfigs   *v1beta1.FRRConfigurationList
+		expectedTemplates *v1beta1.BGPPeerTemplateList
+	}{
+		{
+			desc:            "template updated, referencing config validated with the new template",
+			existingConfigs: []v1beta1.FRRConfiguration{existingConfig, referencingConfig},
+			template:        &updatedTemplate,
+			expectedConfigs: &v1beta1.FRRConfigurationList{
+				Items: []v1beta1.FRRConfiguration{existingConfig, referencingConfig},
+			},
+			expectedTemplates: &v1beta1.BGPPeerTemplateList{
+				Items: []v1beta1.BGPPeerTemplate{otherTemplate, updatedTemplate},
+			},
+		},
+		{
+			desc:            "template updated, referencing config invalid",
+			existingConfigs: []v1beta1.FRRConfiguration{referencingConfig},
+			template:        &updatedTemplate,
+			failValidate:    true,
+			expectedConfigs: &v1beta1.FRRConfigurationList{
+				Items: []v1beta1.FRRConfiguration{referencingConfig},
+			},
+			expectedTemplates: &v1beta1.BGPPeerTemplateList{
+				Items: []v1beta1.BGPPeerTemplate{otherTemplate, updatedTemplate},
+			},
+		},
+		{
+			desc:            "template referenced only by a listen range, referencing config invalid",
+			existingConfigs: []v1beta1.FRRConfiguration{existingConfig, listenRangeConfig},
+			template:        &updatedTemplate,
+			failValidate:    true,
+			expectedConfigs: &v1beta1.FRRConfigurationList{
+				Items: []v1beta1.FRRConfiguration{existingConfig, listenRangeConfig},
+			},
+			expectedTemplates: &v1beta1.BGPPeerTemplateList{
+				Items: []v1beta1.BGPPeerTemplate{otherTemplate, updatedTemplate},
+			},
+		},
+		{
+			desc:            "template not referenced, no validation",
+			existingConfigs: []v1beta1.FRRConfiguration{existingConfig},
+			template:        &updatedTemplate,
+			failValidate:    true,
+		},
+		{
+			desc:            "template in another namespace, no validation",
+			existingConfigs: []v1beta1.FRRConfiguration{referencingConfig},
+			template: &v1beta1.BGPPeerTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "template",
+					Namespace: "other-namespace",
+				},
+			},
+			failValidate: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			getFRRConfigurations = func() (*v1beta1.FRRConfigurationList, error) {
+				return &v1beta1.FRRConfigurationList{
+					Items: test.existingConfigs,
+				}, nil
+			}
+			getBGPPeerTemplates = func() (*v1beta1.BGPPeerTemplateList, error) {
+				return &v1beta1.BGPPeerTemplateList{
+					Items: []v1beta1.BGPPeerTemplate{existingTemplate, otherTemplate},
+				}, nil
+			}
+			mock := &mockValidator{}
+			Validate = mock.Validate
+			mock.forceError = test.failValidate
+
+			err := validateTemplate(test.template)
+			if test.failValidate && test.expectedConfigs != nil && err == nil {
+				t.Fatalf("test %s failed, expecting error", test.desc)
+			}
+			if test.expectedConfigs == nil && err != nil {
+				t.Fatalf("test %s failed, expecting no validation, got %s", test.desc, err)
+			}
+
+			if !cmp.Equal(test.expectedConfigs, mock.configs) {
+				t.Fatalf("test %s failed, %s", test.desc, cmp.Diff(test.expectedConfigs, mock.configs))
+			}
+			if !cmp.Equal(test.expectedTemplates, mock.templates) {
+				t.Fatalf("test %s failed, %s", test.desc, cmp.Diff(test.expectedTemplates, mock.templates))
+			}
+		})
+	}
+}
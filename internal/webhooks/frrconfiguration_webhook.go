@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"reflect"
 	"sync"
 
 	"errors"
@@ -28,6 +29,9 @@ var (
 	Logger        log.Logger
 	WebhookClient client.Reader
 	Validate      func(resources ...client.ObjectList) error
+	// Namespace is the namespace the cluster scoped resources
+	// such as the peer templates are read from.
+	Namespace string
 )
 
 const (
@@ -163,6 +167,11 @@ func validateConfig(frrConfig *v1beta1.FRRConfiguration) ([]string, error) {
 		return warnings, err
 	}
 
+	existingTemplates, err := getBGPPeerTemplates()
+	if err != nil {
+		return warnings, err
+	}
+
 	matchingNodes := []nodeAndConfigs{}
 	for _, n := range existingNodes {
 		if selector.Matches(labels.Set(n.Labels)) {
@@ -192,9 +201,13 @@ func validateConfig(frrConfig *v1beta1.FRRConfiguration) ([]string, error) {
 						if rold.VRF != rnew.VRF {
 							continue
 						}
+						if !reflect.DeepEqual(rold.GracefulRestart, rnew.GracefulRestart) {
+							warnings = append(warnings, "Graceful restart configuration changed, it will be available on the next restart")
+						}
 						for _, nold := range rold.Neighbors {
 							for _, nnew := range rnew.Neighbors {
-								if nold.ASN == nnew.ASN && nold.Address == nnew.Address && nold.EnableGracefulRestart != nnew.EnableGracefulRestart {
+								if nold.ASN == nnew.ASN && nold.Address == nnew.Address &&
+									(nold.EnableGracefulRestart != nnew.EnableGracefulRestart || nold.GracefulRestartMode != nnew.GracefulRestartMode) {
 									warnings = append(warnings, "Graceful restart configuration changed, it will be available on the next restart")
 									continue
 								}
@@ -213,7 +226,7 @@ func validateConfig(frrConfig *v1beta1.FRRConfiguration) ([]string, error) {
 	}
 
 	for _, n := range matchingNodes {
-		err := Validate(n.cfgs)
+		err := Validate(n.cfgs, existingTemplates)
 		if err != nil {
 			return warnings, errors.Join(err, fmt.Errorf("resource is invalid for node %s", n.name))
 		}
@@ -231,6 +244,15 @@ var getFRRConfigurations = func() (*v1beta1.FRRConfigurationList, error) {
 	return frrConfigurationsList, nil
 }
 
+var getBGPPeerTemplates = func() (*v1beta1.BGPPeerTemplateList, error) {
+	templatesList := &v1beta1.BGPPeerTemplateList{}
+	err := WebhookClient.List(context.Background(), templatesList, client.InNamespace(Namespace))
+	if err != nil {
+		return nil, errors.Join(err, errors.New("failed to get existing BGPPeerTemplate objects"))
+	}
+	return templatesList, nil
+}
+
 var getNodes = func() ([]corev1.Node, error) {
 	nodesList := &corev1.NodeList{}
 	err := WebhookClient.List(context.Background(), nodesList)
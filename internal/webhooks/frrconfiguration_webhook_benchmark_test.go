@@ -18,11 +18,13 @@ func BenchmarkValidateConfig(b *testing.B) {
 	configs := generateFRRConfigurations(nodes, 20)
 	originalGetNodes := getNodes
 	originalGetFRRConfigurations := getFRRConfigurations
+	originalGetBGPPeerTemplates := getBGPPeerTemplates
 	originalValidate := Validate
 
 	defer func() {
 		getNodes = originalGetNodes
 		getFRRConfigurations = originalGetFRRConfigurations
+		getBGPPeerTemplates = originalGetBGPPeerTemplates
 		Validate = originalValidate
 	}()
 
@@ -34,6 +36,10 @@ func BenchmarkValidateConfig(b *testing.B) {
 		return &v1beta1.FRRConfigurationList{Items: configs}, nil
 	}
 
+	getBGPPeerTemplates = func() (*v1beta1.BGPPeerTemplateList, error) {
+		return &v1beta1.BGPPeerTemplateList{}, nil
+	}
+
 	Validate = controller.Validate
 
 	testConfig := &v1beta1.FRRConfiguration{
@@ -4,6 +4,7 @@ package webhooks
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-kit/log"
 	"github.com/google/go-cmp/cmp"
@@ -24,7 +25,11 @@ var existingConfig = v1beta1.FRRConfiguration{
 func TestValidateFRRConfiguration(t *testing.T) {
 	Logger = log.NewNopLogger()
 	toRestore := getFRRConfigurations
+	toRestoreTemplates := getBGPPeerTemplates
 	toRestoreNodes := getNodes
+	getBGPPeerTemplates = func() (*v1beta1.BGPPeerTemplateList, error) {
+		return &v1beta1.BGPPeerTemplateList{}, nil
+	}
 	getNodes = func() ([]v1core.Node, error) {
 		return []v1core.Node{
 			{
@@ -40,6 +45,7 @@ func TestValidateFRRConfiguration(t *testing.T) {
 
 	defer func() {
 		getFRRConfigurations = toRestore
+		getBGPPeerTemplates = toRestoreTemplates
 		getNodes = toRestoreNodes
 	}()
 
@@ -211,6 +217,137 @@ func TestValidateFRRConfiguration(t *testing.T) {
 			failValidate: false,
 			warnings:     []string{"Graceful restart configuration changed, it will be available on the next restart"},
 		},
+		{
+			desc: "return warning when gracefulRestartMode changes",
+			before: &v1beta1.FRRConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-config",
+					Namespace: TestNamespace,
+				},
+				Spec: v1beta1.FRRConfigurationSpec{
+					BGP: v1beta1.BGPConfig{
+						Routers: []v1beta1.Router{
+							{
+								Neighbors: []v1beta1.Neighbor{
+									{
+										ASN:                 65002,
+										GracefulRestartMode: v1beta1.GracefulRestartHelper,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			config: &v1beta1.FRRConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-config",
+					Namespace: TestNamespace,
+				},
+				Spec: v1beta1.FRRConfigurationSpec{
+					BGP: v1beta1.BGPConfig{
+						Routers: []v1beta1.Router{
+							{
+								Neighbors: []v1beta1.Neighbor{
+									{
+										ASN:                 65002,
+										GracefulRestartMode: v1beta1.GracefulRestartDisabled,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			isNew: false,
+			expected: &v1beta1.FRRConfigurationList{
+				Items: []v1beta1.FRRConfiguration{
+					*&v1beta1.FRRConfiguration{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-config",
+							Namespace: TestNamespace,
+						},
+						Spec: v1beta1.FRRConfigurationSpec{
+							BGP: v1beta1.BGPConfig{
+								Routers: []v1beta1.Router{
+									{
+										Neighbors: []v1beta1.Neighbor{
+											{
+												ASN:                 65002,
+												GracefulRestartMode: v1beta1.GracefulRestartDisabled,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			failValidate: false,
+			warnings:     []string{"Graceful restart configuration changed, it will be available on the next restart"},
+		},
+		{
+			desc: "return warning when the graceful restart timers change",
+			before: &v1beta1.FRRConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-config",
+					Namespace: TestNamespace,
+				},
+				Spec: v1beta1.FRRConfigurationSpec{
+					BGP: v1beta1.BGPConfig{
+						Routers: []v1beta1.Router{
+							{
+								GracefulRestart: &v1beta1.GracefulRestart{
+									RestartTime: &metav1.Duration{Duration: time.Minute},
+								},
+							},
+						},
+					},
+				},
+			},
+			config: &v1beta1.FRRConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-config",
+					Namespace: TestNamespace,
+				},
+				Spec: v1beta1.FRRConfigurationSpec{
+					BGP: v1beta1.BGPConfig{
+						Routers: []v1beta1.Router{
+							{
+								GracefulRestart: &v1beta1.GracefulRestart{
+									RestartTime: &metav1.Duration{Duration: 2 * time.Minute},
+								},
+							},
+						},
+					},
+				},
+			},
+			isNew: false,
+			expected: &v1beta1.FRRConfigurationList{
+				Items: []v1beta1.FRRConfiguration{
+					*&v1beta1.FRRConfiguration{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "test-config",
+							Namespace: TestNamespace,
+						},
+						Spec: v1beta1.FRRConfigurationSpec{
+							BGP: v1beta1.BGPConfig{
+								Routers: []v1beta1.Router{
+									{
+										GracefulRestart: &v1beta1.GracefulRestart{
+											RestartTime: &metav1.Duration{Duration: 2 * time.Minute},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			failValidate: false,
+			warnings:     []string{"Graceful restart configuration changed, it will be available on the next restart"},
+		},
 		{
 			desc:   "warning when disableMP is set",
 			before: &existingConfig,
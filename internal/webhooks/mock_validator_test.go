@@ -12,6 +12,7 @@ import (
 
 type mockValidator struct {
 	configs    *v1beta1.FRRConfigurationList
+	templates  *v1beta1.BGPPeerTemplateList
 	nodes      *v1.NodeList
 	forceError bool
 }
@@ -21,6 +22,8 @@ func (m *mockValidator) Validate(objects ...client.ObjectList) error {
 		switch list := obj.(type) {
 		case *v1beta1.FRRConfigurationList:
 			m.configs = list
+		case *v1beta1.BGPPeerTemplateList:
+			m.templates = list
 		case *v1.NodeList:
 			m.nodes = list
 		default:
@@ -14,6 +14,7 @@ import (
 
 type ApiV1beta1Interface interface {
 	RESTClient() rest.Interface
+	BGPPeerTemplatesGetter
 	FRRConfigurationsGetter
 	FRRK8sConfigurationsGetter
 }
@@ -23,6 +24,10 @@ type ApiV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *ApiV1beta1Client) BGPPeerTemplates(namespace string) BGPPeerTemplateInterface {
+	return newBGPPeerTemplates(c, namespace)
+}
+
 func (c *ApiV1beta1Client) FRRConfigurations(namespace string) FRRConfigurationInterface {
 	return newFRRConfigurations(c, namespace)
 }
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier:Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+
+	apiv1beta1 "github.com/metallb/frr-k8s/api/v1beta1"
+	scheme "github.com/metallb/frr-k8s/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// BGPPeerTemplatesGetter has a method to return a BGPPeerTemplateInterface.
+// A group's client should implement this interface.
+type BGPPeerTemplatesGetter interface {
+	BGPPeerTemplates(namespace string) BGPPeerTemplateInterface
+}
+
+// BGPPeerTemplateInterface has methods to work with BGPPeerTemplate resources.
+type BGPPeerTemplateInterface interface {
+	Create(ctx context.Context, bGPPeerTemplate *apiv1beta1.BGPPeerTemplate, opts v1.CreateOptions) (*apiv1beta1.BGPPeerTemplate, error)
+	Update(ctx context.Context, bGPPeerTemplate *apiv1beta1.BGPPeerTemplate, opts v1.UpdateOptions) (*apiv1beta1.BGPPeerTemplate, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, bGPPeerTemplate *apiv1beta1.BGPPeerTemplate, opts v1.UpdateOptions) (*apiv1beta1.BGPPeerTemplate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1beta1.BGPPeerTemplate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1beta1.BGPPeerTemplateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1beta1.BGPPeerTemplate, err error)
+	BGPPeerTemplateExpansion
+}
+
+// bGPPeerTemplates implements BGPPeerTemplateInterface
+type bGPPeerTemplates struct {
+	*gentype.ClientWithList[*apiv1beta1.BGPPeerTemplate, *apiv1beta1.BGPPeerTemplateList]
+}
+
+// newBGPPeerTemplates returns a BGPPeerTemplates
+func newBGPPeerTemplates(c *ApiV1beta1Client, namespace string) *bGPPeerTemplates {
+	return &bGPPeerTemplates{
+		gentype.NewClientWithList[*apiv1beta1.BGPPeerTemplate, *apiv1beta1.BGPPeerTemplateList](
+			"bgppeertemplates",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1beta1.BGPPeerTemplate { return &apiv1beta1.BGPPeerTemplate{} },
+			func() *apiv1beta1.BGPPeerTemplateList { return &apiv1beta1.BGPPeerTemplateList{} },
+		),
+	}
+}
@@ -14,6 +14,10 @@ type FakeApiV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeApiV1beta1) BGPPeerTemplates(namespace string) v1beta1.BGPPeerTemplateInterface {
+	return newFakeBGPPeerTemplates(c, namespace)
+}
+
 func (c *FakeApiV1beta1) FRRConfigurations(namespace string) v1beta1.FRRConfigurationInterface {
 	return newFakeFRRConfigurations(c, namespace)
 }
@@ -0,0 +1,38 @@
+// SPDX-License-Identifier:Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/metallb/frr-k8s/api/v1beta1"
+	apiv1beta1 "github.com/metallb/frr-k8s/pkg/client/clientset/versioned/typed/api/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeBGPPeerTemplates implements BGPPeerTemplateInterface
+type fakeBGPPeerTemplates struct {
+	*gentype.FakeClientWithList[*v1beta1.BGPPeerTemplate, *v1beta1.BGPPeerTemplateList]
+	Fake *FakeApiV1beta1
+}
+
+func newFakeBGPPeerTemplates(fake *FakeApiV1beta1, namespace string) apiv1beta1.BGPPeerTemplateInterface {
+	return &fakeBGPPeerTemplates{
+		gentype.NewFakeClientWithList[*v1beta1.BGPPeerTemplate, *v1beta1.BGPPeerTemplateList](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("bgppeertemplates"),
+			v1beta1.SchemeGroupVersion.WithKind("BGPPeerTemplate"),
+			func() *v1beta1.BGPPeerTemplate { return &v1beta1.BGPPeerTemplate{} },
+			func() *v1beta1.BGPPeerTemplateList { return &v1beta1.BGPPeerTemplateList{} },
+			func(dst, src *v1beta1.BGPPeerTemplateList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.BGPPeerTemplateList) []*v1beta1.BGPPeerTemplate {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.BGPPeerTemplateList, items []*v1beta1.BGPPeerTemplate) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
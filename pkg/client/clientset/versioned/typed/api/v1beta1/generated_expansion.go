@@ -4,6 +4,8 @@
 
 package v1beta1
 
+type BGPPeerTemplateExpansion interface{}
+
 type FRRConfigurationExpansion interface{}
 
 type FRRK8sConfigurationExpansion interface{}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier:Apache-2.0
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	context "context"
+	time "time"
+
+	frrk8sapiv1beta1 "github.com/metallb/frr-k8s/api/v1beta1"
+	versioned "github.com/metallb/frr-k8s/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/metallb/frr-k8s/pkg/client/informers/externalversions/internalinterfaces"
+	apiv1beta1 "github.com/metallb/frr-k8s/pkg/client/listers/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BGPPeerTemplateInformer provides access to a shared informer and lister for
+// BGPPeerTemplates.
+type BGPPeerTemplateInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() apiv1beta1.BGPPeerTemplateLister
+}
+
+type bGPPeerTemplateInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewBGPPeerTemplateInformer constructs a new informer for BGPPeerTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewBGPPeerTemplateInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredBGPPeerTemplateInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredBGPPeerTemplateInformer constructs a new informer for BGPPeerTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredBGPPeerTemplateInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApiV1beta1().BGPPeerTemplates(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApiV1beta1().BGPPeerTemplates(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApiV1beta1().BGPPeerTemplates(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ApiV1beta1().BGPPeerTemplates(namespace).Watch(ctx, options)
+			},
+		},
+		&frrk8sapiv1beta1.BGPPeerTemplate{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *bGPPeerTemplateInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredBGPPeerTemplateInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *bGPPeerTemplateInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&frrk8sapiv1beta1.BGPPeerTemplate{}, f.defaultInformer)
+}
+
+func (f *bGPPeerTemplateInformer) Lister() apiv1beta1.BGPPeerTemplateLister {
+	return apiv1beta1.NewBGPPeerTemplateLister(f.Informer().GetIndexer())
+}
@@ -10,6 +10,8 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// BGPPeerTemplates returns a BGPPeerTemplateInformer.
+	BGPPeerTemplates() BGPPeerTemplateInformer
 	// FRRConfigurations returns a FRRConfigurationInformer.
 	FRRConfigurations() FRRConfigurationInformer
 	// FRRK8sConfigurations returns a FRRK8sConfigurationInformer.
@@ -27,6 +29,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// BGPPeerTemplates returns a BGPPeerTemplateInformer.
+func (v *version) BGPPeerTemplates() BGPPeerTemplateInformer {
+	return &bGPPeerTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // FRRConfigurations returns a FRRConfigurationInformer.
 func (v *version) FRRConfigurations() FRRConfigurationInformer {
 	return &fRRConfigurationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
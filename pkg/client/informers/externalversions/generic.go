@@ -39,6 +39,8 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=api, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("bgppeertemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Api().V1beta1().BGPPeerTemplates().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("frrconfigurations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Api().V1beta1().FRRConfigurations().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("frrk8sconfigurations"):
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier:Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	apiv1beta1 "github.com/metallb/frr-k8s/api/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// BGPPeerTemplateLister helps list BGPPeerTemplates.
+// All objects returned here must be treated as read-only.
+type BGPPeerTemplateLister interface {
+	// List lists all BGPPeerTemplates in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1beta1.BGPPeerTemplate, err error)
+	// BGPPeerTemplates returns an object that can list and get BGPPeerTemplates.
+	BGPPeerTemplates(namespace string) BGPPeerTemplateNamespaceLister
+	BGPPeerTemplateListerExpansion
+}
+
+// bGPPeerTemplateLister implements the BGPPeerTemplateLister interface.
+type bGPPeerTemplateLister struct {
+	listers.ResourceIndexer[*apiv1beta1.BGPPeerTemplate]
+}
+
+// NewBGPPeerTemplateLister returns a new BGPPeerTemplateLister.
+func NewBGPPeerTemplateLister(indexer cache.Indexer) BGPPeerTemplateLister {
+	return &bGPPeerTemplateLister{listers.New[*apiv1beta1.BGPPeerTemplate](indexer, apiv1beta1.Resource("bgppeertemplate"))}
+}
+
+// BGPPeerTemplates returns an object that can list and get BGPPeerTemplates.
+func (s *bGPPeerTemplateLister) BGPPeerTemplates(namespace string) BGPPeerTemplateNamespaceLister {
+	return bGPPeerTemplateNamespaceLister{listers.NewNamespaced[*apiv1beta1.BGPPeerTemplate](s.ResourceIndexer, namespace)}
+}
+
+// BGPPeerTemplateNamespaceLister helps list and get BGPPeerTemplates.
+// All objects returned here must be treated as read-only.
+type BGPPeerTemplateNamespaceLister interface {
+	// List lists all BGPPeerTemplates in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1beta1.BGPPeerTemplate, err error)
+	// Get retrieves the BGPPeerTemplate from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1beta1.BGPPeerTemplate, error)
+	BGPPeerTemplateNamespaceListerExpansion
+}
+
+// bGPPeerTemplateNamespaceLister implements the BGPPeerTemplateNamespaceLister
+// interface.
+type bGPPeerTemplateNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1beta1.BGPPeerTemplate]
+}
@@ -4,6 +4,14 @@
 
 package v1beta1
 
+// BGPPeerTemplateListerExpansion allows custom methods to be added to
+// BGPPeerTemplateLister.
+type BGPPeerTemplateListerExpansion interface{}
+
+// BGPPeerTemplateNamespaceListerExpansion allows custom methods to be added to
+// BGPPeerTemplateNamespaceLister.
+type BGPPeerTemplateNamespaceListerExpansion interface{}
+
 // FRRConfigurationListerExpansion allows custom methods to be added to
 // FRRConfigurationLister.
 type FRRConfigurationListerExpansion interface{}